@@ -3,16 +3,111 @@ package interact
 
 import (
 	"bufio"
+	"errors"
 	"io"
+	"sync"
 )
 
-// An Actor provides methods to interact with the user
+var (
+	// ErrInterrupted is returned when the user presses Ctrl-C while the
+	// terminal is in raw mode (e.g. in Select) or while an AsyncCheck is
+	// running. Otherwise, e.g. in Prompt and Confirm, Ctrl-C is delivered
+	// to the program as a signal as usual.
+	ErrInterrupted = errors.New("Interrupted")
+	// ErrEndOfInput is returned when the input ends (or the user presses
+	// Ctrl-D) before the user has typed anything on the line. It wraps io.EOF.
+	ErrEndOfInput error = endOfInputError{}
+	// ErrCanceled is returned when the user declines to try again after a
+	// failed check
+	ErrCanceled = errors.New("Command aborted")
+)
+
+type endOfInputError struct{}
+
+func (endOfInputError) Error() string {
+	return "End of input"
+}
+
+func (endOfInputError) Unwrap() error {
+	return io.EOF
+}
+
+// An Actor provides methods to interact with the user. An Actor (and all of its
+// copies, such as the ones returned by the With* methods) can be used from
+// multiple goroutines at once: the interactions are queued and performed one
+// at a time, so that the messages and answers of one interaction are never
+// mixed with those of another. Because of this, input checks must not use the
+// Actor to interact with the user themselves.
 type Actor struct {
-	rd *bufio.Reader
-	w  io.Writer
+	in         io.Reader
+	rd         *bufio.Reader
+	w          io.Writer
+	mu         *sync.Mutex
+	locked     bool
+	status     *status
+	transforms []Transform
+	allChecks  bool
+	listChecks []ListCheck
+	ui         frontend
+	id         string
+	clearToken string
+	help       string
+	helpToken  string
+	maxLength  int
+	// sanitizeMessages is set by WithSanitizedMessages
+	sanitizeMessages bool
+	// rejected is the reason the previous answer was rejected, shown with the
+	// question when it's asked again through a frontend
+	rejected error
 }
 
 // NewActor creates a new Actor instance with the specified io.Reader
 func NewActor(rd io.Reader, w io.Writer) Actor {
-	return Actor{bufio.NewReader(rd), w}
+	return Actor{
+		in:         rd,
+		rd:         bufio.NewReader(rd),
+		w:          w,
+		mu:         &sync.Mutex{},
+		status:     &status{},
+		transforms: []Transform{TrimSpace},
+		helpToken:  "?",
+		maxLength:  defaultMaxLength,
+	}
+}
+
+// lock waits until no other goroutine is interacting with the user through the
+// Actor and returns a copy of the Actor that holds the lock until unlock is
+// called. Methods called on the copy don't try to acquire the lock again.
+func (a Actor) lock() (locked Actor, unlock func()) {
+	if a.locked {
+		return a, func() {}
+	}
+	a.mu.Lock()
+	a.locked = true
+	return a, a.mu.Unlock
+}
+
+// readLine reads a line of input without the line terminator. A partial
+// line at the end of the input is returned as is, but if the input ends on an
+// empty line, ErrEndOfInput is returned. Lines that are too long or contain
+// characters that aren't allowed are rejected, see WithMaxLength.
+func (a Actor) readLine() (string, error) {
+	line, err := readBoundedLine(a.rd, a.maxLength)
+	if err == io.EOF && line == "" {
+		return "", ErrEndOfInput
+	} else if err != nil && err != io.EOF {
+		return "", err
+	}
+	line = TrimNewline(line)
+	if err := validateInput(line); err != nil {
+		return "", err
+	}
+	return line, nil
+}
+
+// isFinal reports whether the error means that the user can't or doesn't want
+// to continue the interaction, in which case there's no point in asking them
+// to try again
+func isFinal(err error) bool {
+	return errors.Is(err, ErrInterrupted) || errors.Is(err, ErrEndOfInput) || errors.Is(err, ErrCanceled)
 }
@@ -0,0 +1,135 @@
+package interact_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Actor", func() {
+	Describe("used from multiple goroutines", func() {
+		const n = 20
+		var checkNotBad = func(input string) error {
+			// give the other goroutines a chance to interfere
+			time.Sleep(time.Millisecond)
+			if input == "bad" {
+				return errors.New("Bad input!")
+			}
+			return nil
+		}
+
+		BeforeEach(func() {
+			userInput = strings.Repeat("bad\ny\ngood\n", n)
+		})
+
+		It("should perform one interaction at a time", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < n; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					input, err := actor.PromptAndRetry(fmt.Sprintf("Question %d", i), checkNotBad)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(input).To(Equal("good"))
+				}(i)
+			}
+			wg.Wait()
+
+			exchange := regexp.MustCompile(`Question (\d+): Bad input!\nDo you want to try again\? \[y/N\]: Question (\d+): `)
+			exchanges := exchange.FindAllStringSubmatch(string(output.Contents()), -1)
+			Expect(exchanges).To(HaveLen(n))
+			for _, e := range exchanges {
+				Expect(e[1]).To(Equal(e[2]))
+			}
+		})
+	})
+
+	Describe("outcomes", func() {
+		var (
+			message  = "Please answer"
+			checkErr = errors.New("Check failed!")
+			failing  = func(string) error {
+				return checkErr
+			}
+		)
+
+		Context("with the input ending on an empty line", func() {
+			BeforeEach(func() {
+				userInput = ""
+			})
+
+			It("should return ErrEndOfInput from Prompt", func() {
+				_, err := actor.Prompt(message)
+				Expect(err).To(Equal(interact.ErrEndOfInput))
+				Expect(errors.Is(err, io.EOF)).To(BeTrue())
+			})
+
+			It("should return ErrEndOfInput from Confirm", func() {
+				_, err := actor.Confirm(message, interact.ConfirmDefaultToYes)
+				Expect(err).To(Equal(interact.ErrEndOfInput))
+			})
+
+			It("should not ask to retry", func() {
+				_, err := actor.PromptAndRetry(message)
+				Expect(err).To(Equal(interact.ErrEndOfInput))
+				Consistently(output).ShouldNot(gbytes.Say(`try again`))
+			})
+		})
+
+		Context("with the input ending on a partial line", func() {
+			BeforeEach(func() {
+				userInput = "user-input"
+			})
+
+			It("should return the partial line", func() {
+				input, err := actor.Prompt(message)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(input).To(Equal("user-input"))
+			})
+		})
+
+		Context("with the input ending when asked to retry", func() {
+			BeforeEach(func() {
+				userInput = "user-input\n"
+			})
+
+			It("should return ErrEndOfInput", func() {
+				_, err := actor.PromptAndRetry(message, failing)
+				Expect(err).To(Equal(interact.ErrEndOfInput))
+			})
+		})
+
+		Context("with the user declining to retry", func() {
+			BeforeEach(func() {
+				userInput = "user-input\nn\n"
+			})
+
+			It("should return ErrCanceled", func() {
+				_, err := actor.PromptAndRetry(message, failing)
+				Expect(err).To(Equal(interact.ErrCanceled))
+			})
+		})
+	})
+
+	Describe("WithID", func() {
+		BeforeEach(func() {
+			userInput = "answer\n"
+		})
+
+		It("should keep an Actor an Actor", func() {
+			i := interact.WithID(actor, "id")
+			Expect(i).To(BeAssignableToTypeOf(interact.Actor{}))
+			Expect(i.Prompt("Question")).To(Equal("answer"))
+		})
+	})
+})
@@ -0,0 +1,53 @@
+package interact
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"time"
+)
+
+var (
+	errCheckTimedOut = errors.New("The check took too long, please try again")
+)
+
+// AsyncInputCheck specifies the function signature for an input check that may
+// take a while, for example because it queries a service or the file system.
+// The check should return as soon as possible once the context is done.
+type AsyncInputCheck func(ctx context.Context, input string) error
+
+// AsyncCheck converts an AsyncInputCheck into an InputCheck, so that it can be
+// used alongside other checks. While the check is running, a spinner is shown
+// on the Actor's writer. The check's context is canceled once the timeout
+// passes or the user presses Ctrl-C, in which case the returned InputCheck
+// fails without waiting for the check to return, with ErrInterrupted in case
+// of the latter. A timeout of zero means no timeout.
+func (a Actor) AsyncCheck(timeout time.Duration, check AsyncInputCheck) InputCheck {
+	return func(input string) error {
+		ctx := context.Background()
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+
+		spinner := a.startSpinner("Checking")
+		defer spinner.Stop()
+		errc := make(chan error, 1)
+		go func() {
+			errc <- check(ctx, input)
+		}()
+		select {
+		case err := <-errc:
+			return err
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return errCheckTimedOut
+			}
+			return ErrInterrupted
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package interact_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("AsyncCheck", func() {
+	var message = "Please answer"
+
+	BeforeEach(func() {
+		userInput = "user-input\n"
+	})
+
+	It("should pass the input to the check", func() {
+		input, err := actor.Prompt(message, actor.AsyncCheck(time.Second, func(ctx context.Context, input string) error {
+			Expect(input).To(Equal("user-input"))
+			return nil
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(input).To(Equal("user-input"))
+	})
+
+	It("should show that the check is running", func() {
+		actor.Prompt(message, actor.AsyncCheck(time.Second, func(ctx context.Context, input string) error {
+			return nil
+		}))
+		Eventually(output).Should(gbytes.Say(`Please answer: Checking\.\.\.`))
+	})
+
+	It("should return the error from the check", func() {
+		checkErr := errors.New("Check failed!")
+		_, err := actor.Prompt(message, actor.AsyncCheck(time.Second, func(ctx context.Context, input string) error {
+			return checkErr
+		}))
+		Expect(err).To(Equal(checkErr))
+	})
+
+	It("should give up on the check after the timeout", func() {
+		_, err := actor.Prompt(message, actor.AsyncCheck(10*time.Millisecond, func(ctx context.Context, input string) error {
+			<-ctx.Done()
+			time.Sleep(time.Second)
+			return nil
+		}))
+		Expect(err).To(MatchError(`The check took too long, please try again`))
+	})
+
+	It("should not set a deadline without a timeout", func() {
+		_, err := actor.Prompt(message, actor.AsyncCheck(0, func(ctx context.Context, input string) error {
+			_, ok := ctx.Deadline()
+			Expect(ok).To(BeFalse())
+			return nil
+		}))
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
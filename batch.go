@@ -0,0 +1,66 @@
+package interact
+
+import (
+	"sync"
+)
+
+var (
+	// batchChoices are the answers to the confirmations of a Batch
+	batchChoices = []Choice{
+		{Key: "y", Description: "yes", Aliases: []string{"yes"}},
+		{Key: "n", Description: "no", Aliases: []string{"no"}},
+		{Key: "a", Description: "yes to this and all of the remaining items", Aliases: []string{"all"}},
+		{Key: "N", Description: "no to this and all of the remaining items", Aliases: []string{"none"}},
+		{Key: "q", Description: "quit", Aliases: []string{"quit"}},
+	}
+)
+
+// A Batch asks the user to confirm the items of a batch one by one, e.g. the
+// files a migration is about to change. Besides yes and no, the user can
+// answer yes or no to all of the remaining items at once, or quit. A Batch can
+// be used from multiple goroutines at once.
+type Batch struct {
+	a    Actor
+	mu   sync.Mutex
+	all  *bool
+	quit bool
+}
+
+// Batch creates a new Batch for confirming the items of a batch
+func (a Actor) Batch() *Batch {
+	return &Batch{a: a}
+}
+
+// Confirm asks the user to confirm the item described by the message with one
+// of y (yes), n (no), a (yes to all remaining items), N (no to all remaining
+// items) or q (quit), see Choose. The words yes, no, all, none and quit are
+// accepted as well. Once the user has answered a or N, the answer is returned
+// for the rest of the batch without asking. Once the user has quit,
+// ErrCanceled is returned for the rest of the batch.
+func (b *Batch) Confirm(message string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.quit {
+		return false, ErrCanceled
+	} else if b.all != nil {
+		return *b.all, nil
+	}
+	key, err := b.a.Choose(message, batchChoices)
+	if err != nil {
+		return false, err
+	}
+	switch key {
+	case "a":
+		b.remember(true)
+	case "N":
+		b.remember(false)
+	case "q":
+		b.quit = true
+		return false, ErrCanceled
+	}
+	return key == "y" || key == "a", nil
+}
+
+func (b *Batch) remember(confirmed bool) {
+	b.all = &confirmed
+}
@@ -0,0 +1,122 @@
+package interact_test
+
+import (
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Batch", func() {
+	var batch *interact.Batch
+
+	JustBeforeEach(func() {
+		batch = actor.Batch()
+	})
+
+	confirmAll := func(files ...string) []bool {
+		var answers []bool
+		for _, file := range files {
+			confirmed, err := batch.Confirm("Migrate " + file + "?")
+			Expect(err).NotTo(HaveOccurred())
+			answers = append(answers, confirmed)
+		}
+		return answers
+	}
+
+	Context("with yes and no", func() {
+		BeforeEach(func() {
+			userInput = "y\nno\n"
+		})
+
+		It("should ask for every item", func() {
+			Expect(confirmAll("a", "b")).To(Equal([]bool{true, false}))
+			Eventually(output).Should(gbytes.Say(`Migrate a\? \[y,n,a,N,q,\?\]: `))
+			Eventually(output).Should(gbytes.Say(`Migrate b\? \[y,n,a,N,q,\?\]: `))
+		})
+	})
+
+	Context("with yes to all", func() {
+		BeforeEach(func() {
+			userInput = "n\na\n"
+		})
+
+		It("should confirm the remaining items without asking", func() {
+			Expect(confirmAll("a", "b", "c", "d")).To(Equal([]bool{false, true, true, true}))
+			Expect(output.Contents()).NotTo(ContainSubstring("Migrate c"))
+		})
+	})
+
+	Context("with no to all", func() {
+		BeforeEach(func() {
+			userInput = "y\nN\n"
+		})
+
+		It("should decline the remaining items without asking", func() {
+			Expect(confirmAll("a", "b", "c")).To(Equal([]bool{true, false, false}))
+		})
+	})
+
+	Context("with yes and all as words", func() {
+		BeforeEach(func() {
+			userInput = "yes\nall\n"
+		})
+
+		It("should accept them", func() {
+			Expect(confirmAll("a", "b", "c")).To(Equal([]bool{true, true, true}))
+		})
+	})
+
+	Context("with no and none as words", func() {
+		BeforeEach(func() {
+			userInput = "no\nnone\n"
+		})
+
+		It("should accept them", func() {
+			Expect(confirmAll("a", "b", "c")).To(Equal([]bool{false, false, false}))
+		})
+	})
+
+	Context("with quit as a word", func() {
+		BeforeEach(func() {
+			userInput = "quit\n"
+		})
+
+		It("should return ErrCanceled", func() {
+			_, err := batch.Confirm("Migrate a?")
+			Expect(err).To(Equal(interact.ErrCanceled))
+		})
+	})
+
+	Context("with quitting", func() {
+		BeforeEach(func() {
+			userInput = "y\nq\n"
+		})
+
+		It("should return ErrCanceled", func() {
+			Expect(batch.Confirm("Migrate a?")).To(BeTrue())
+			_, err := batch.Confirm("Migrate b?")
+			Expect(err).To(Equal(interact.ErrCanceled))
+		})
+
+		It("should keep returning ErrCanceled without asking", func() {
+			batch.Confirm("Migrate a?")
+			batch.Confirm("Migrate b?")
+			_, err := batch.Confirm("Migrate c?")
+			Expect(err).To(Equal(interact.ErrCanceled))
+			Expect(string(output.Contents())).NotTo(ContainSubstring("Migrate c?"))
+		})
+	})
+
+	Context("with an invalid answer", func() {
+		BeforeEach(func() {
+			userInput = "maybe\n\ny\n"
+		})
+
+		It("should ask again", func() {
+			Expect(batch.Confirm("Migrate a?")).To(BeTrue())
+			Eventually(output).Should(gbytes.Say(`Please select one of y, n, a, N or q!\n`))
+			Eventually(output).Should(gbytes.Say(`Please select one of y, n, a, N or q!\n`))
+		})
+	})
+})
@@ -0,0 +1,96 @@
+package interact
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Choice is one of the answers of Choose
+type Choice struct {
+	// Key is what the user enters to make the choice, usually a single
+	// letter. Keys are case sensitive.
+	Key string
+	// Description is shown when the user asks for help
+	Description string
+	// Aliases are other answers that make the choice, such as whole words
+	Aliases []string
+}
+
+// Choose asks the user to make one of the choices by entering its key and
+// returns the key. The aliases of a choice are accepted in place of its key,
+// but aren't shown. The keys are shown after the message like [y,n,q,?] and
+// entering the help token (? by default, see WithHelpToken) lists the
+// descriptions of the choices, along with the help text set with WithHelp,
+// before asking again. An error is returned if there are no choices or if the
+// key or an alias of a choice is the help token.
+func (a Actor) Choose(message string, choices []Choice) (string, error) {
+	if len(choices) == 0 {
+		return "", errNoOptions
+	}
+	for _, choice := range choices {
+		for _, answer := range append([]string{choice.Key}, choice.Aliases...) {
+			if a.helpToken != "" && answer == a.helpToken {
+				return "", fmt.Errorf("The help token %s can't be the key or an alias of a choice", a.helpToken)
+			}
+		}
+	}
+	a, unlock := a.lock()
+	defer unlock()
+	keys := make([]string, len(choices))
+	descriptions := make([]string, len(choices))
+	for i, choice := range choices {
+		keys[i], descriptions[i] = choice.Key, a.sanitize(choice.Description)
+	}
+	message = a.sanitize(message)
+	for {
+		input, err := a.askChoice(message, keys, descriptions)
+		if isInvalidInput(err) {
+			fmt.Fprintln(a.w, err)
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		if a.helpToken != "" && strings.TrimSpace(input) == a.helpToken {
+			if a.help != "" {
+				fmt.Fprintln(a.w, a.help)
+			}
+			for i, key := range keys {
+				fmt.Fprintf(a.w, "%s - %s\n", key, descriptions[i])
+			}
+			continue
+		}
+		input = a.transform(input)
+		for _, choice := range choices {
+			if input == choice.Key {
+				return choice.Key, nil
+			}
+			for _, alias := range choice.Aliases {
+				if input == alias {
+					return choice.Key, nil
+				}
+			}
+		}
+		fmt.Fprintf(a.w, "Please select one of %s!\n", orList(keys))
+	}
+}
+
+func (a Actor) askChoice(message string, keys, descriptions []string) (string, error) {
+	if a.ui != nil {
+		a.status.clear()
+		return a.ui.ask(question{ID: a.id, Kind: kindChoice, Message: message, Options: keys, Descriptions: descriptions, Help: a.help})
+	}
+	if a.helpToken != "" {
+		keys = append(keys, a.helpToken)
+	}
+	prompt := fmt.Sprintf("%s [%s]", message, strings.Join(keys, ","))
+	a.help = ""
+	return a.askLine(question{Kind: kindInput, Message: prompt})
+}
+
+// orList joins the strings into a list like "a, b or c"
+func orList(strs []string) string {
+	if len(strs) < 2 {
+		return strings.Join(strs, "")
+	}
+	return strings.Join(strs[:len(strs)-1], ", ") + " or " + strs[len(strs)-1]
+}
@@ -0,0 +1,98 @@
+package interact_test
+
+import (
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Choose", func() {
+	var choices = []interact.Choice{
+		{Key: "a", Description: "accept this change"},
+		{Key: "s", Description: "skip this change"},
+		{Key: "e", Description: "edit this change"},
+		{Key: "q", Description: "quit"},
+	}
+
+	Context("with a valid key", func() {
+		BeforeEach(func() {
+			userInput = "e\n"
+		})
+
+		It("should return the key", func() {
+			Expect(actor.Choose("Apply this hunk?", choices)).To(Equal("e"))
+			Eventually(output).Should(gbytes.Say(`Apply this hunk\? \[a,s,e,q,\?\]: `))
+		})
+	})
+
+	Context("with the user asking for help", func() {
+		BeforeEach(func() {
+			userInput = "?\ns\n"
+		})
+
+		It("should list the descriptions and ask again", func() {
+			Expect(actor.Choose("Apply this hunk?", choices)).To(Equal("s"))
+			Eventually(output).Should(gbytes.Say(`a - accept this change\ns - skip this change\ne - edit this change\nq - quit\n`))
+			Eventually(output).Should(gbytes.Say(`Apply this hunk\? \[a,s,e,q,\?\]: `))
+		})
+	})
+
+	Context("with a custom help token", func() {
+		BeforeEach(func() {
+			userInput = "h\n?\n"
+		})
+
+		It("should list the descriptions for the token and accept ? as a key", func() {
+			withQuestionMark := append([]interact.Choice{}, choices...)
+			withQuestionMark[3] = interact.Choice{Key: "?", Description: "quit"}
+			Expect(actor.WithHelpToken("h").Choose("Apply this hunk?", withQuestionMark)).To(Equal("?"))
+			Eventually(output).Should(gbytes.Say(`Apply this hunk\? \[a,s,e,\?,h\]: a - accept this change\n`))
+		})
+	})
+
+	Context("with a key that is the help token", func() {
+		It("should return an error", func() {
+			withQuestionMark := append([]interact.Choice{}, choices...)
+			withQuestionMark[3] = interact.Choice{Key: "?", Description: "quit"}
+			_, err := actor.Choose("Apply this hunk?", withQuestionMark)
+			Expect(err).To(MatchError("The help token ? can't be the key or an alias of a choice"))
+		})
+	})
+
+	Context("with no choices", func() {
+		BeforeEach(func() {
+			userInput = "q\n"
+		})
+
+		It("should return an error without asking", func() {
+			_, err := actor.Choose("Apply this hunk?", nil)
+			Expect(err).To(MatchError("There are no options to select from!"))
+			Expect(output.Contents()).To(BeEmpty())
+		})
+	})
+
+	Context("with an alias", func() {
+		BeforeEach(func() {
+			userInput = "quit\n"
+		})
+
+		It("should return the key of the choice", func() {
+			withAlias := append([]interact.Choice{}, choices...)
+			withAlias[3].Aliases = []string{"quit", "exit"}
+			Expect(actor.Choose("Apply this hunk?", withAlias)).To(Equal("q"))
+			Eventually(output).Should(gbytes.Say(`Apply this hunk\? \[a,s,e,q,\?\]: `))
+		})
+	})
+
+	Context("with an invalid key", func() {
+		BeforeEach(func() {
+			userInput = "A\nq\n"
+		})
+
+		It("should ask again", func() {
+			Expect(actor.Choose("Apply this hunk?", choices)).To(Equal("q"))
+			Eventually(output).Should(gbytes.Say(`Please select one of a, s, e or q!\n`))
+		})
+	})
+})
@@ -23,11 +23,16 @@ const (
 
 // Confirm provides the message to the user and asks yes or no. If the user
 // doesn't select either of the possible answers they will be prompted to answer
-// again until they do
+// again until they do. If the answer looks like a typo of "yes" or "no", the
+// user is asked whether that's what they meant. Like with Prompt, Ctrl-C is
+// delivered to the program as a signal and ErrInterrupted is never returned.
 func (a Actor) Confirm(message string, def ConfirmDefault) (confirmed bool, err error) {
+	a, unlock := a.lock()
+	defer unlock()
+	message = a.sanitize(message)
 	for {
 		confirmed, err := a.confirmOnce(message, def)
-		if err == errNoOptionSelected {
+		if err == errNoOptionSelected || isInvalidInput(err) {
 			fmt.Fprintln(a.w, err)
 			continue
 		}
@@ -36,22 +41,12 @@ func (a Actor) Confirm(message string, def ConfirmDefault) (confirmed bool, err
 }
 
 func (a Actor) confirmOnce(message string, def ConfirmDefault) (bool, error) {
-	var options string
-	switch def {
-	case ConfirmDefaultToYes:
-		options = "[Y/n]"
-	case ConfirmDefaultToNo:
-		options = "[y/N]"
-	case ConfirmNoDefault:
-		options = "[y/n]"
-	}
-	fmt.Fprintf(a.w, "%s %s: ", message, options)
-
-	line, err := a.rd.ReadString('\n')
-	input := strings.TrimSpace(line)
+	line, err := a.askConfirm(message, def)
 	if err != nil {
 		return false, err
-	} else if input == "" {
+	}
+	input := strings.TrimSpace(line)
+	if input == "" {
 		switch def {
 		case ConfirmDefaultToYes:
 			return true, nil
@@ -62,10 +57,53 @@ func (a Actor) confirmOnce(message string, def ConfirmDefault) (bool, error) {
 		}
 	}
 	switch input {
-	case "y":
+	case "y", "yes":
 		return true, nil
-	case "n":
+	case "n", "no":
 		return false, nil
 	}
+	if suggestion, ok := suggest(input, []string{"yes", "no"}); ok {
+		accepted, err := a.withoutHelp().Confirm(fmt.Sprintf("Did you mean '%s'?", suggestion), ConfirmDefaultToYes)
+		if err != nil {
+			return false, err
+		} else if accepted {
+			return suggestion == "yes", nil
+		}
+	}
 	return false, errNoOptionSelected
 }
+
+func (a Actor) askConfirm(message string, def ConfirmDefault) (string, error) {
+	if a.ui != nil {
+		q := question{ID: a.id, Kind: kindConfirm, Message: message, Help: a.help}
+		switch def {
+		case ConfirmDefaultToYes:
+			q.Default = "y"
+		case ConfirmDefaultToNo:
+			q.Default = "n"
+		}
+		a.status.clear()
+		return a.ui.ask(q)
+	}
+	var options string
+	switch def {
+	case ConfirmDefaultToYes:
+		options = "Y/n"
+	case ConfirmDefaultToNo:
+		options = "y/N"
+	case ConfirmNoDefault:
+		options = "y/n"
+	}
+	if a.help != "" {
+		options += "/" + a.helpToken
+	}
+	for {
+		a.status.clear()
+		fmt.Fprintf(a.w, "%s [%s]: ", message, options)
+		line, err := a.readLine()
+		if err != nil || a.help == "" || strings.TrimSpace(line) != a.helpToken {
+			return line, err
+		}
+		fmt.Fprintln(a.w, a.help)
+	}
+}
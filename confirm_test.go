@@ -48,6 +48,30 @@ var _ = Describe("Confirm", func() {
 			})
 		})
 
+		Context("with user answering yes in full", func() {
+			BeforeEach(func() {
+				userInput = "yes\n"
+			})
+
+			It("should return true", func() {
+				confirmed, err := actor.Confirm(message, def)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(confirmed).To(BeTrue())
+			})
+		})
+
+		Context("with user answering no in full", func() {
+			BeforeEach(func() {
+				userInput = "no\n"
+			})
+
+			It("should return false", func() {
+				confirmed, err := actor.Confirm(message, def)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(confirmed).To(BeFalse())
+			})
+		})
+
 		Context("with user answering nothing and then y", func() {
 			BeforeEach(func() {
 				userInput = "\ny\n"
@@ -0,0 +1,133 @@
+package interact
+
+import "strings"
+
+// secretMask is displayed instead of secret values, such as the value of a
+// secret Default, without revealing their length
+const secretMask = "****"
+
+// A Default is the default option of a prompt that's computed when the prompt
+// is asked, for example from earlier answers or from the environment
+type Default struct {
+	// Value returns the default option. It's called every time the prompt is
+	// asked. If it fails, the prompt returns the error.
+	Value func() (string, error)
+	// Label is displayed to the user instead of the value, if set
+	Label string
+	// Secret masks the value when it's displayed, unless Label is set
+	Secret bool
+}
+
+// display returns how the default option is displayed to the user
+func (def Default) display(value string) string {
+	if def.Label != "" {
+		return def.Label
+	} else if def.Secret && value != "" {
+		return secretMask
+	}
+	return value
+}
+
+// DefaultValue returns a Default with a fixed value
+func DefaultValue(value string) Default {
+	return Default{Value: func() (string, error) {
+		return value, nil
+	}}
+}
+
+// AnswerSource is where the value of an Answer came from
+type AnswerSource int
+
+// Possible sources of the value of an Answer
+const (
+	// FromUser means the user entered the value
+	FromUser AnswerSource = iota
+	// FromDefault means the user accepted the default option
+	FromDefault
+	// Cleared means the user entered the clear token, so the value is empty
+	Cleared
+)
+
+// An Answer is the value returned by a prompt with a default option along with
+// where it came from
+type Answer struct {
+	Value  string
+	Source AnswerSource
+}
+
+// WithClearToken returns a copy of the Actor that lets the user answer prompts
+// with a default option with no value at all, by entering the token (e.g. "-")
+func (a Actor) WithClearToken(token string) Actor {
+	a.clearToken = token
+	return a
+}
+
+// PromptDefault works exactly like PromptOptional, but the default option is
+// computed when the prompt is asked and can be displayed differently from its
+// value
+func (a Actor) PromptDefault(message string, def Default, checks ...InputCheck) (string, error) {
+	answer, err := a.PromptAnswer(message, def, checks...)
+	return answer.Value, err
+}
+
+// PromptDefaultAndRetry works exactly like PromptOptionalAndRetry, but the
+// default option is computed every time the prompt is asked and can be
+// displayed differently from its value
+func (a Actor) PromptDefaultAndRetry(message string, def Default, checks ...InputCheck) (string, error) {
+	answer, err := a.PromptAnswerAndRetry(message, def, checks...)
+	return answer.Value, err
+}
+
+// PromptAnswer works exactly like PromptDefault, but also reports whether the
+// user entered the value, accepted the default or cleared the value with the
+// token set with WithClearToken
+func (a Actor) PromptAnswer(message string, def Default, checks ...InputCheck) (Answer, error) {
+	a, unlock := a.lock()
+	defer unlock()
+	var value string
+	if def.Value != nil {
+		var err error
+		if value, err = def.Value(); err != nil {
+			return Answer{}, err
+		}
+	}
+	q := question{
+		Kind:       kindInput,
+		Message:    a.sanitize(message),
+		Default:    a.sanitize(def.display(value)),
+		HasDefault: true,
+		ClearToken: a.clearToken,
+	}
+	input, err := a.ask(q)
+	if err != nil {
+		return Answer{}, err
+	}
+	switch strings.TrimSpace(input) {
+	case "":
+		return Answer{value, FromDefault}, nil
+	case a.clearToken:
+		return Answer{"", Cleared}, nil
+	}
+	input, err = a.checkInput(a.transform(input), checks...)
+	if err != nil {
+		return Answer{}, err
+	}
+	return Answer{input, FromUser}, nil
+}
+
+// PromptAnswerAndRetry works exactly like PromptDefaultAndRetry, but also
+// reports where the value came from, see PromptAnswer
+func (a Actor) PromptAnswerAndRetry(message string, def Default, checks ...InputCheck) (Answer, error) {
+	a, unlock := a.lock()
+	defer unlock()
+	var answer Answer
+	_, err := a.retry(func(a Actor) (string, error) {
+		var err error
+		answer, err = a.PromptAnswer(message, def, checks...)
+		return answer.Value, err
+	})
+	if err != nil {
+		return Answer{}, err
+	}
+	return answer, nil
+}
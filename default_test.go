@@ -0,0 +1,142 @@
+package interact_test
+
+import (
+	"errors"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("PromptDefault", func() {
+	var (
+		calls int
+		def   interact.Default
+	)
+
+	BeforeEach(func() {
+		calls = 0
+		def = interact.Default{Value: func() (string, error) {
+			calls++
+			return "s3cr3t", nil
+		}}
+		userInput = "\n"
+	})
+
+	It("should compute the default when asked", func() {
+		Expect(calls).To(Equal(0))
+		Expect(actor.PromptDefault("Token", def)).To(Equal("s3cr3t"))
+		Expect(calls).To(Equal(1))
+		Eventually(output).Should(gbytes.Say(`Token: \(s3cr3t\) `))
+	})
+
+	It("should display the label instead of the value", func() {
+		def.Label = "from git config"
+		Expect(actor.PromptDefault("Token", def)).To(Equal("s3cr3t"))
+		Eventually(output).Should(gbytes.Say(`Token: \(from git config\) `))
+	})
+
+	It("should mask a secret value", func() {
+		def.Secret = true
+		Expect(actor.PromptDefault("Token", def)).To(Equal("s3cr3t"))
+		Eventually(output).Should(gbytes.Say(`Token: \(\*\*\*\*\) `))
+		Expect(output.Contents()).NotTo(ContainSubstring("s3cr3t"))
+	})
+
+	It("should return the error of the default", func() {
+		valueErr := errors.New("No git config!")
+		def.Value = func() (string, error) {
+			return "", valueErr
+		}
+		_, err := actor.PromptDefault("Token", def)
+		Expect(err).To(Equal(valueErr))
+	})
+
+	Context("with the user entering something", func() {
+		BeforeEach(func() {
+			userInput = "other\n"
+		})
+
+		It("should return the input", func() {
+			Expect(actor.PromptDefault("Token", def)).To(Equal("other"))
+		})
+	})
+
+	Context("with retrying", func() {
+		BeforeEach(func() {
+			userInput = "bad\ny\n\n"
+		})
+
+		It("should compute the default again for every attempt", func() {
+			input, err := actor.PromptDefaultAndRetry("Token", def, func(input string) error {
+				if input == "bad" {
+					return errors.New("Bad token!")
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("s3cr3t"))
+			Expect(calls).To(Equal(2))
+		})
+	})
+})
+
+var _ = Describe("PromptAnswer", func() {
+	var def = interact.DefaultValue("eu-west-1")
+
+	Context("with the user accepting the default", func() {
+		BeforeEach(func() {
+			userInput = "\n"
+		})
+
+		It("should report that the value is the default", func() {
+			Expect(actor.PromptAnswer("Region", def)).To(Equal(interact.Answer{Value: "eu-west-1", Source: interact.FromDefault}))
+		})
+	})
+
+	Context("with the user entering a value", func() {
+		BeforeEach(func() {
+			userInput = "us-east-1\n"
+		})
+
+		It("should report that the user entered the value", func() {
+			Expect(actor.PromptAnswer("Region", def)).To(Equal(interact.Answer{Value: "us-east-1", Source: interact.FromUser}))
+		})
+	})
+
+	Context("with the user entering the clear token", func() {
+		BeforeEach(func() {
+			userInput = "-\n"
+		})
+
+		It("should return an empty value if the token is set", func() {
+			answer, err := actor.WithClearToken("-").PromptAnswer("Region", def)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(answer).To(Equal(interact.Answer{Value: "", Source: interact.Cleared}))
+			Eventually(output).Should(gbytes.Say(`Region: \(eu-west-1, - to clear\) `))
+		})
+
+		It("should clear the value of PromptOptional", func() {
+			Expect(actor.WithClearToken("-").PromptOptional("Region", "eu-west-1")).To(Equal(""))
+		})
+
+		It("should return the token as is if the token isn't set", func() {
+			Expect(actor.PromptAnswer("Region", def)).To(Equal(interact.Answer{Value: "-", Source: interact.FromUser}))
+		})
+	})
+
+	Context("with retrying", func() {
+		BeforeEach(func() {
+			userInput = "bad\ny\n-\n"
+		})
+
+		It("should report where the final value came from", func() {
+			answer, err := actor.WithClearToken("-").PromptAnswerAndRetry("Region", def, func(input string) error {
+				return errors.New("Unknown region!")
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(answer).To(Equal(interact.Answer{Value: "", Source: interact.Cleared}))
+		})
+	})
+})
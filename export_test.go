@@ -0,0 +1,9 @@
+package interact
+
+// WrapLines exports wrapLines for testing
+var WrapLines = wrapLines
+
+// PageKeys exports pageKeys for testing
+func (a Actor) PageKeys(lines []string, height int) error {
+	return a.pageKeys(lines, height)
+}
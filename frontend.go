@@ -0,0 +1,106 @@
+package interact
+
+import (
+	"fmt"
+	"strings"
+)
+
+// questionKind is the kind of answer a question expects
+type questionKind string
+
+const (
+	kindInput       questionKind = "input"
+	kindSecret      questionKind = "secret"
+	kindConfirm     questionKind = "confirm"
+	kindSelect      questionKind = "select"
+	kindMultiSelect questionKind = "multiselect"
+	kindChoice      questionKind = "choice"
+)
+
+// question describes a single prompt independently of how it's presented to
+// the user
+type question struct {
+	// ID identifies the prompt, see Actor.WithID
+	ID      string
+	Kind    questionKind
+	Message string
+	// Default is the answer used if the user doesn't enter anything, as it's
+	// displayed to the user: the default option for input, "y" or "n" for
+	// confirm
+	Default string
+	// HasDefault is set if the question has a default option, even if it's
+	// displayed as an empty string
+	HasDefault bool
+	// ClearToken is what the user can enter to answer an input question with
+	// a default with no value at all, if set
+	ClearToken string
+	// Options are the options of select and multiselect questions, or the
+	// keys of choice questions
+	Options []string
+	// Descriptions are the descriptions of the keys of choice questions
+	Descriptions []string
+	// Help is a longer explanation of the question, if set
+	Help string
+	// Error is the reason the previous answer to the question was rejected
+	Error string
+}
+
+// A frontend presents questions to the user as a whole, for example as forms
+// in a browser, instead of as lines of text. The answers are the text entered
+// for input and secret questions, "y", "n" or "" for confirm questions, the
+// indexes of the selected options separated by commas for select and
+// multiselect questions, and the key of the choice for choice questions.
+type frontend interface {
+	ask(q question) (string, error)
+}
+
+// ask asks the user an input or a secret question, either through the Actor's
+// frontend or on the lines of its reader and writer. The answer isn't
+// transformed, so that the caller can recognize special answers like an empty
+// line regardless of the Actor's transforms.
+func (a Actor) ask(q question) (string, error) {
+	if a.ui == nil {
+		return a.askLine(q)
+	}
+	q.ID = a.id
+	q.Help = a.help
+	if a.rejected != nil {
+		q.Error = a.rejected.Error()
+	}
+	a.status.clear()
+	answer, err := a.ui.ask(q)
+	if err != nil {
+		return "", err
+	} else if err := a.validateAnswer(answer); err != nil {
+		return "", err
+	}
+	return answer, nil
+}
+
+// askLine asks the question on a line. If the Actor has a help text, the help
+// token shows it and asks the question again.
+func (a Actor) askLine(q question) (string, error) {
+	message := q.Message
+	if a.help != "" {
+		message = fmt.Sprintf("%s (%s for help)", message, a.helpToken)
+	}
+	for {
+		var (
+			input string
+			err   error
+		)
+		if q.Default != "" && q.ClearToken != "" {
+			input, err = a.promptLine(fmt.Sprintf("%s: (%s, %s to clear) ", message, q.Default, q.ClearToken))
+		} else if q.HasDefault {
+			input, err = a.promptLine(fmt.Sprintf("%s: (%s) ", message, q.Default))
+		} else if q.Kind == kindSecret {
+			input, err = a.promptSecret(message + ": ")
+		} else {
+			input, err = a.promptLine(message + ": ")
+		}
+		if err != nil || a.help == "" || strings.TrimSpace(input) != a.helpToken {
+			return input, err
+		}
+		fmt.Fprintln(a.w, a.help)
+	}
+}
@@ -0,0 +1,196 @@
+package interact
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	// maxListedOptions is the number of options shown at once when selecting
+	// from a list
+	maxListedOptions = 10
+)
+
+// FuzzySelect asks the user to select one of a potentially long list of
+// options and returns the index of the selected option. On a terminal, typing
+// filters the options by fuzzy matching as the user types, the arrow keys move
+// between the matches and enter selects one. Otherwise the user enters a
+// filter and then picks one of the numbered matches or enters a new filter. An
+// error is returned if there are no options.
+func (a Actor) FuzzySelect(message string, options []string) (int, error) {
+	if len(options) == 0 {
+		return -1, errNoOptions
+	}
+	a, unlock := a.lock()
+	defer unlock()
+	a.status.clear()
+	message, options = a.sanitize(message), a.sanitizeAll(options)
+	if a.ui != nil {
+		selected, err := a.askSelect(kindSelect, message, options)
+		if err != nil {
+			return -1, err
+		}
+		return selected[0], nil
+	} else if a.interactive() {
+		return a.fuzzySelectKeys(message, options)
+	}
+	return a.fuzzySelectLines(message, options)
+}
+
+func (a Actor) fuzzySelectKeys(message string, options []string) (int, error) {
+	var (
+		filter   []rune
+		cursor   int
+		selected = -1
+		s        = screen{a.w}
+	)
+	err := a.withRawMode(func() error {
+		for {
+			matches := fuzzyFilter(string(filter), options)
+			if cursor >= len(matches) {
+				cursor = len(matches) - 1
+			}
+			if cursor < 0 {
+				cursor = 0
+			}
+			items := make([]string, len(matches))
+			for i, index := range matches {
+				items[i] = options[index]
+			}
+			s.draw(listLines(fmt.Sprintf("%s: %s", message, string(filter)), items, cursor)...)
+
+			k, err := readKey(a.rd)
+			if err != nil {
+				return err
+			}
+			switch k {
+			case keyUp:
+				if cursor > 0 {
+					cursor--
+				}
+			case keyDown:
+				if cursor < len(matches)-1 {
+					cursor++
+				}
+			case keyBackspace:
+				if len(filter) > 0 {
+					filter = filter[:len(filter)-1]
+					cursor = 0
+				}
+			case keyEnter:
+				if len(matches) > 0 {
+					selected = matches[cursor]
+					s.finish(fmt.Sprintf("%s: %s", message, options[selected]))
+					return nil
+				}
+			case keyInterrupt:
+				s.finish(message + ":")
+				return ErrInterrupted
+			case keyEndOfInput:
+				s.finish(message + ":")
+				return ErrEndOfInput
+			default:
+				if k >= 0 && a.fits(string(filter), rune(k)) {
+					filter = append(filter, rune(k))
+					cursor = 0
+				}
+			}
+		}
+	})
+	return selected, err
+}
+
+func (a Actor) fuzzySelectLines(message string, options []string) (int, error) {
+	filter, err := a.promptValid(message + ": ")
+	if err != nil {
+		return -1, err
+	}
+	for {
+		matches := fuzzyFilter(filter, options)
+		if len(matches) == 0 {
+			fmt.Fprintln(a.w, "No matches")
+			if filter, err = a.promptValid("Enter a new filter: "); err != nil {
+				return -1, err
+			}
+			continue
+		}
+		listed := matches
+		if len(listed) > maxListedOptions {
+			listed = listed[:maxListedOptions]
+		}
+		for i, index := range listed {
+			fmt.Fprintf(a.w, "%d) %s\n", i+1, options[index])
+		}
+		if hidden := len(matches) - len(listed); hidden > 0 {
+			fmt.Fprintf(a.w, "(%d more)\n", hidden)
+		}
+		input, err := a.promptValid("Enter a number or a new filter: ")
+		if err != nil {
+			return -1, err
+		}
+		if n, err := strconv.Atoi(input); err == nil && n >= 1 && n <= len(listed) {
+			return listed[n-1], nil
+		}
+		filter = input
+	}
+}
+
+// fuzzyFilter returns the indexes of the options that match the filter, best
+// matches first. An empty filter matches all options in their original order.
+func fuzzyFilter(filter string, options []string) []int {
+	if filter == "" {
+		indexes := make([]int, len(options))
+		for i := range options {
+			indexes[i] = i
+		}
+		return indexes
+	}
+	type match struct {
+		index, score int
+	}
+	var matches []match
+	for i, option := range options {
+		if score, ok := fuzzyScore(filter, option); ok {
+			matches = append(matches, match{i, score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return len(options[matches[i].index]) < len(options[matches[j].index])
+	})
+	indexes := make([]int, len(matches))
+	for i, m := range matches {
+		indexes[i] = m.index
+	}
+	return indexes
+}
+
+// fuzzyScore reports whether all characters of the filter appear in the option
+// in the same order, ignoring case. Matches at the start of words and runs of
+// consecutive characters increase the score.
+func fuzzyScore(filter, option string) (int, bool) {
+	f := []rune(strings.ToLower(filter))
+	o := []rune(strings.ToLower(option))
+	var score, fi int
+	prev := -2
+	for oi := 0; oi < len(o) && fi < len(f); oi++ {
+		if o[oi] != f[fi] {
+			continue
+		}
+		score++
+		if oi == prev+1 {
+			score += 5
+		}
+		if oi == 0 || !unicode.IsLetter(o[oi-1]) && !unicode.IsDigit(o[oi-1]) {
+			score += 10
+		}
+		prev = oi
+		fi++
+	}
+	return score, fi == len(f)
+}
@@ -0,0 +1,150 @@
+package interact_test
+
+import (
+	"fmt"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("FuzzySelect", func() {
+	var (
+		message = "Repository"
+		options = []string{"deiwin/interact", "deiwin/luncher-api", "golang/go", "onsi/ginkgo", "onsi/gomega"}
+	)
+
+	Context("with the user filtering and selecting a number", func() {
+		BeforeEach(func() {
+			userInput = "gin\n1\n"
+		})
+
+		It("should return the index of the selected option", func() {
+			i, err := actor.FuzzySelect(message, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(i).To(Equal(3))
+		})
+
+		It("should list the matches", func() {
+			actor.FuzzySelect(message, options)
+			Eventually(output).Should(gbytes.Say(`Repository: 1\) onsi/ginkgo\nEnter a number or a new filter: `))
+		})
+	})
+
+	Context("with the user matching characters that are not next to each other", func() {
+		BeforeEach(func() {
+			userInput = "dwlun\n1\n"
+		})
+
+		It("should match the option", func() {
+			i, err := actor.FuzzySelect(message, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(i).To(Equal(1))
+		})
+	})
+
+	Context("with the user entering an empty filter", func() {
+		BeforeEach(func() {
+			userInput = "\n5\n"
+		})
+
+		It("should list all options in order", func() {
+			i, err := actor.FuzzySelect(message, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(i).To(Equal(4))
+			Eventually(output).Should(gbytes.Say(`1\) deiwin/interact\n2\) deiwin/luncher-api\n3\) golang/go\n4\) onsi/ginkgo\n5\) onsi/gomega\n`))
+		})
+	})
+
+	Context("with the user refining the filter", func() {
+		BeforeEach(func() {
+			userInput = "onsi\nmega\n1\n"
+		})
+
+		It("should list the new matches", func() {
+			i, err := actor.FuzzySelect(message, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(i).To(Equal(4))
+			Eventually(output).Should(gbytes.Say(`1\) onsi/ginkgo\n2\) onsi/gomega\nEnter a number or a new filter: 1\) onsi/gomega\n`))
+		})
+	})
+
+	Context("with a filter that matches nothing", func() {
+		BeforeEach(func() {
+			userInput = "xyz\ngo\n1\n"
+		})
+
+		It("should ask for a new filter", func() {
+			i, err := actor.FuzzySelect(message, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(i).To(Equal(2))
+			Eventually(output).Should(gbytes.Say(`No matches\nEnter a new filter: `))
+		})
+	})
+
+	Context("with many options", func() {
+		var many []string
+
+		BeforeEach(func() {
+			many = nil
+			for i := 0; i < 1000; i++ {
+				many = append(many, fmt.Sprintf("pod-%d", i))
+			}
+			userInput = "pod-99\n2\n"
+		})
+
+		It("should list only the best matches", func() {
+			i, err := actor.FuzzySelect(message, many)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(many[i]).To(Equal("pod-990"))
+			Eventually(output).Should(gbytes.Say(`1\) pod-99\n`))
+			Eventually(output).Should(gbytes.Say(`10\) pod-998\n\(\d+ more\)\n`))
+		})
+	})
+
+	Context("with the input ending", func() {
+		BeforeEach(func() {
+			userInput = "go\n"
+		})
+
+		It("should return the error", func() {
+			_, err := actor.FuzzySelect(message, options)
+			Expect(err).To(Equal(interact.ErrEndOfInput))
+		})
+	})
+
+	Context("with an invalid filter", func() {
+		BeforeEach(func() {
+			userInput = "g\x00n\ngin\n1\n"
+		})
+
+		It("should ask for the filter again", func() {
+			i, err := actor.FuzzySelect(message, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(i).To(Equal(3))
+			Eventually(output).Should(gbytes.Say(`Repository: The answer can not contain control characters!\nRepository: 1\) onsi/ginkgo\n`))
+		})
+	})
+
+	Context("with an invalid number", func() {
+		BeforeEach(func() {
+			userInput = "gin\n1\x00\n1\n"
+		})
+
+		It("should ask for the number again", func() {
+			i, err := actor.FuzzySelect(message, options)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(i).To(Equal(3))
+			Eventually(output).Should(gbytes.Say(`Enter a number or a new filter: The answer can not contain control characters!\nEnter a number or a new filter: `))
+		})
+	})
+
+	Context("with no options", func() {
+		It("should return an error without asking", func() {
+			_, err := actor.FuzzySelect(message, nil)
+			Expect(err).To(MatchError("There are no options to select from!"))
+			Expect(output.Contents()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,28 @@
+package interact
+
+// WithHelp returns a copy of the Actor that explains its prompts with the
+// help text when the user answers with the help token (? by default). The
+// prompt is then asked again, without counting as a failed attempt. This is
+// usually set for a single prompt, e.g.
+//
+//	actor.WithHelp("The ARN of the role to assume, e.g. arn:aws:iam::123456789012:role/deploy").Prompt("IAM role ARN")
+func (a Actor) WithHelp(text string) Actor {
+	a.help = text
+	return a
+}
+
+// WithHelpToken returns a copy of the Actor that shows the help text set with
+// WithHelp when the user answers with the token instead of ?
+func (a Actor) WithHelpToken(token string) Actor {
+	a.helpToken = token
+	return a
+}
+
+// withoutHelp returns a copy of the Actor without a help text, for the
+// confirmations the library asks on its own, such as whether to try again,
+// which the help text of the original prompt doesn't explain
+func (a Actor) withoutHelp() Actor {
+	a.help = ""
+	a.helpToken = ""
+	return a
+}
@@ -0,0 +1,104 @@
+package interact_test
+
+import (
+	"errors"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("WithHelp", func() {
+	var help = "The ARN of the role to assume"
+
+	Context("with the user asking for help", func() {
+		BeforeEach(func() {
+			userInput = "?\narn:aws:iam::1:role/deploy\n"
+		})
+
+		It("should show the help and ask again", func() {
+			input, err := actor.WithHelp(help).Prompt("IAM role ARN")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("arn:aws:iam::1:role/deploy"))
+			Eventually(output).Should(gbytes.Say(`IAM role ARN \(\? for help\): The ARN of the role to assume\n`))
+			Eventually(output).Should(gbytes.Say(`IAM role ARN \(\? for help\): $`))
+		})
+
+		It("should not count as a failed attempt", func() {
+			check := func(input string) error {
+				if input == "?" {
+					return errors.New("Checked the help token!")
+				}
+				return nil
+			}
+			input, err := actor.WithHelp(help).PromptAndRetry("IAM role ARN", check)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("arn:aws:iam::1:role/deploy"))
+			Expect(output.Contents()).NotTo(ContainSubstring("try again"))
+		})
+
+		It("should return ? as is without a help text", func() {
+			Expect(actor.Prompt("IAM role ARN")).To(Equal("?"))
+		})
+	})
+
+	Context("with a check failing", func() {
+		BeforeEach(func() {
+			userInput = "bad\n?\ny\ngood\n"
+		})
+
+		It("should not show the help in the confirmations of the library", func() {
+			input, err := actor.WithHelp(help).PromptAndRetry("IAM role ARN", func(input string) error {
+				if input == "bad" {
+					return errors.New("Not an ARN!")
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("good"))
+			Eventually(output).Should(gbytes.Say(`Not an ARN!\nDo you want to try again\? \[y/N\]: Please select y/n!\n`))
+			Eventually(output).Should(gbytes.Say(`Do you want to try again\? \[y/N\]: IAM role ARN \(\? for help\): `))
+			Expect(output.Contents()).NotTo(ContainSubstring(help))
+		})
+	})
+
+	Context("with a custom token", func() {
+		BeforeEach(func() {
+			userInput = "help\n\n"
+		})
+
+		It("should show the help for the token", func() {
+			input, err := actor.WithHelp(help).WithHelpToken("help").PromptOptional("IAM role ARN", "none")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("none"))
+			Eventually(output).Should(gbytes.Say(`IAM role ARN \(help for help\): \(none\) The ARN of the role to assume\n`))
+		})
+	})
+
+	Context("with Confirm", func() {
+		BeforeEach(func() {
+			userInput = "?\ny\n"
+		})
+
+		It("should show the help and ask again", func() {
+			confirmed, err := actor.WithHelp("Deletes all of the data").Confirm("Drop the table?", interact.ConfirmDefaultToNo)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(confirmed).To(BeTrue())
+			Eventually(output).Should(gbytes.Say(`Drop the table\? \[y/N/\?\]: Deletes all of the data\n`))
+			Eventually(output).Should(gbytes.Say(`Drop the table\? \[y/N/\?\]: `))
+		})
+	})
+
+	Context("with Choose", func() {
+		BeforeEach(func() {
+			userInput = "?\ns\n"
+		})
+
+		It("should show the help with the descriptions of the choices", func() {
+			choices := []interact.Choice{{Key: "a", Description: "accept"}, {Key: "s", Description: "skip"}}
+			Expect(actor.WithHelp("Reviews a hunk").Choose("Apply?", choices)).To(Equal("s"))
+			Eventually(output).Should(gbytes.Say(`Apply\? \[a,s,\?\]: Reviews a hunk\na - accept\ns - skip\n`))
+		})
+	})
+})
@@ -1,107 +1,190 @@
 package interact
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 )
 
-var (
-	errCanceled = errors.New("Command aborted")
-)
-
 // InputCheck specifies the function signature for an input check
 type InputCheck func(string) error
 
+// CheckErrors is returned when more than one check fails for an Actor created
+// with WithAllChecks. It holds the errors of the failed checks, in order.
+type CheckErrors []error
+
+func (errs CheckErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the errors of the failed checks, so that they can be inspected
+// with errors.Is and errors.As
+func (errs CheckErrors) Unwrap() []error {
+	return errs
+}
+
+// WithAllChecks returns a copy of the Actor that keeps running the checks of
+// its prompts after one of them fails, so that all of the failures can be
+// reported to the user at once. If more than one check fails, the returned
+// error is a CheckErrors.
+func (a Actor) WithAllChecks() Actor {
+	a.allChecks = true
+	return a
+}
+
 // PromptAndRetry asks the user for input and performs the list of added checks
 // on the provided input. If any of the checks fail to pass the error will be
 // displayed to the user and they will then be asked if they want to try again.
-// If the user does not want to retry the program will return an error.
+// If the user does not want to retry the program will return an error. If the
+// checks only result in warnings and the user does not want to continue
+// anyway, they will be asked for input again straight away.
 func (a Actor) PromptAndRetry(message string, checks ...InputCheck) (string, error) {
-	for {
-		input, err := a.Prompt(message, checks...)
-		if err != nil {
-			if err = a.confirmRetry(err); err != nil {
-				return "", err
-			}
-			continue
-		}
-		return input, nil
-	}
+	a, unlock := a.lock()
+	defer unlock()
+	return a.retry(func(a Actor) (string, error) {
+		return a.Prompt(message, checks...)
+	})
 }
 
 // PromptOptionalAndRetry works exactly like GetInputAndRetry, but also has
 // a default option which will be used instead if the user simply presses enter.
 func (a Actor) PromptOptionalAndRetry(message, defaultOption string, checks ...InputCheck) (string, error) {
-	for {
-		input, err := a.PromptOptional(message, defaultOption, checks...)
-		if err != nil {
-			if err = a.confirmRetry(err); err != nil {
-				return "", err
-			}
-			continue
-		}
-		return input, nil
-	}
+	a, unlock := a.lock()
+	defer unlock()
+	return a.retry(func(a Actor) (string, error) {
+		return a.PromptOptional(message, defaultOption, checks...)
+	})
 }
 
 // Prompt asks the user for input and performs the list of added checks on the
-// provided input. If any of the checks fail, the error will be returned.
+// provided input. If any of the checks fail, the error will be returned. If
+// the checks only result in warnings, the user is asked whether they want to
+// continue anyway and the first warning is returned if they don't. The line is
+// read in the terminal's normal mode, so Ctrl-C is delivered to the program
+// as a signal as usual and ErrInterrupted is never returned.
 func (a Actor) Prompt(message string, checks ...InputCheck) (string, error) {
-	input, err := a.prompt(message + ": ")
-	if err != nil {
-		return "", err
-	}
-	err = runChecks(input, checks...)
+	a, unlock := a.lock()
+	defer unlock()
+	input, err := a.ask(question{Kind: kindInput, Message: a.sanitize(message)})
 	if err != nil {
 		return "", err
 	}
-	return input, nil
+	input = a.transform(input)
+	return a.checkInput(input, checks...)
 }
 
 // PromptOptional works exactly like Prompt, but also has a default option
 // which will be used instead if the user simply presses enter.
 func (a Actor) PromptOptional(message, defaultOption string, checks ...InputCheck) (string, error) {
-	input, err := a.prompt(fmt.Sprintf("%s: (%s) ", message, defaultOption))
-	if err != nil {
-		return "", err
-	}
-	if input == "" {
-		return defaultOption, nil
-	}
-	err = runChecks(input, checks...)
-	if err != nil {
-		return "", err
+	return a.PromptDefault(message, DefaultValue(defaultOption), checks...)
+}
+
+// retry calls prompt until it succeeds. After an error the user is asked if
+// they want to try again, unless the error is a Warning they've already
+// declined or the interaction can't continue (see isFinal). With a frontend,
+// the question is asked again straight away with the error shown next to it.
+func (a Actor) retry(prompt func(a Actor) (string, error)) (string, error) {
+	for {
+		input, err := prompt(a)
+		if isWarning(err) {
+			continue
+		} else if isFinal(err) {
+			return "", err
+		} else if err != nil {
+			if a.ui != nil {
+				a.rejected = err
+				continue
+			}
+			if err = a.confirmRetry(err); err != nil {
+				return "", err
+			}
+			continue
+		}
+		return input, nil
 	}
-	return input, nil
 }
 
 func (a Actor) confirmRetry(err error) error {
 	retryMessage := fmt.Sprintf("%v\nDo you want to try again?", err)
-	confirmed, err := a.Confirm(retryMessage, ConfirmDefaultToNo)
+	confirmed, err := a.withoutHelp().Confirm(retryMessage, ConfirmDefaultToNo)
 	if err != nil {
 		return err
 	} else if !confirmed {
-		return errCanceled
+		return ErrCanceled
 	}
 	return nil
 }
 
 func (a Actor) prompt(message string) (string, error) {
+	line, err := a.promptLine(message)
+	if err != nil {
+		return "", err
+	}
+	return a.transform(line), nil
+}
+
+// promptLine works like prompt, but returns the line without transforming it
+func (a Actor) promptLine(message string) (string, error) {
+	a.status.clear()
 	fmt.Fprint(a.w, message)
-	line, err := a.rd.ReadString('\n')
+	return a.readLine()
+}
+
+// checkInput runs the checks on the input and returns the input if they pass.
+// If a check fails because the input is not one of the allowed options, the
+// user is offered the closest option instead. If the checks only result in
+// warnings, the warnings are shown to the user and they are asked whether they
+// want to continue anyway. If they don't, the first warning is returned.
+func (a Actor) checkInput(input string, checks ...InputCheck) (string, error) {
+	warnings, err := a.runChecks(input, checks...)
+	if err != nil {
+		suggestion, accepted, confirmErr := a.offerSuggestion(err)
+		if confirmErr != nil {
+			return "", confirmErr
+		} else if accepted {
+			return a.checkInput(suggestion, checks...)
+		}
+		return "", err
+	} else if len(warnings) == 0 {
+		return input, nil
+	}
+	for _, warning := range warnings {
+		fmt.Fprintln(a.w, warning)
+	}
+	confirmed, err := a.withoutHelp().Confirm("Do you want to continue anyway?", ConfirmDefaultToNo)
 	if err != nil {
 		return "", err
+	} else if !confirmed {
+		return "", warnings[0]
 	}
-	return strings.TrimSpace(line), nil
+	return input, nil
 }
 
-func runChecks(input string, checks ...InputCheck) error {
+// runChecks runs the checks until one of them fails with an error other than a
+// Warning, or all of the checks if the Actor was created with WithAllChecks.
+// Warnings are collected and returned separately.
+func (a Actor) runChecks(input string, checks ...InputCheck) (warnings []error, err error) {
+	var errs CheckErrors
 	for _, check := range checks {
 		err := check(input)
-		if err != nil {
-			return err
+		if isWarning(err) {
+			warnings = append(warnings, err)
+		} else if err != nil {
+			if !a.allChecks {
+				return nil, err
+			}
+			errs = append(errs, err)
 		}
 	}
-	return nil
+	switch len(errs) {
+	case 0:
+		return warnings, nil
+	case 1:
+		return nil, errs[0]
+	default:
+		return nil, errs
+	}
 }
@@ -147,6 +147,71 @@ var _ = Describe("Input", func() {
 		})
 	})
 
+	Describe("WithAllChecks", func() {
+		var (
+			firstErr  = errors.New("First check failed!")
+			secondErr = errors.New("Second check failed!")
+			failFirst = func(string) error {
+				return firstErr
+			}
+			failSecond = func(string) error {
+				return secondErr
+			}
+			pass = func(string) error {
+				return nil
+			}
+		)
+
+		BeforeEach(func() {
+			userInput = "user-input\n"
+		})
+
+		Context("with multiple failing checks", func() {
+			It("should return all of the errors", func() {
+				_, err := actor.WithAllChecks().Prompt(message, failFirst, pass, failSecond)
+				Expect(err).To(Equal(interact.CheckErrors{firstErr, secondErr}))
+				Expect(errors.Is(err, secondErr)).To(BeTrue())
+				Expect(err).To(MatchError("First check failed!\nSecond check failed!"))
+			})
+		})
+
+		Context("with a single failing check", func() {
+			It("should return the error as is", func() {
+				_, err := actor.WithAllChecks().Prompt(message, pass, failSecond)
+				Expect(err).To(Equal(secondErr))
+			})
+		})
+
+		Context("with passing checks", func() {
+			It("should return the input", func() {
+				input, err := actor.WithAllChecks().Prompt(message, pass, pass)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(input).To(Equal("user-input"))
+			})
+		})
+
+		Context("with the user retrying", func() {
+			BeforeEach(func() {
+				userInput = "user-input\ny\ncorrect-input\n"
+			})
+
+			It("should display all of the errors", func() {
+				actor.WithAllChecks().PromptAndRetry(message, func(input string) error {
+					if input == "correct-input" {
+						return nil
+					}
+					return firstErr
+				}, func(input string) error {
+					if input == "correct-input" {
+						return nil
+					}
+					return secondErr
+				})
+				Eventually(output).Should(gbytes.Say(`First check failed!\nSecond check failed!\nDo you want to try again\? \[y/N\]: `))
+			})
+		})
+	})
+
 	Describe("PromptOptional", func() {
 		var def = "default value"
 		Context("without any checks", func() {
@@ -188,6 +253,19 @@ var _ = Describe("Input", func() {
 					Eventually(output).Should(gbytes.Say(`Please answer: \(default value\) `))
 				})
 			})
+
+			Context("with an empty default value", func() {
+				BeforeEach(func() {
+					userInput = "\n"
+				})
+
+				It("should still show the parentheses", func() {
+					input, err := actor.PromptOptional(message, "")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(input).To(Equal(""))
+					Eventually(output).Should(gbytes.Say(`^Please answer: \(\) $`))
+				})
+			})
 		})
 
 		Context("with a check", func() {
@@ -0,0 +1,36 @@
+package interact
+
+// Interactor is the interface of the basic interactions of an Actor. Code that
+// interacts with the user can accept an Interactor instead of an Actor, so
+// that its tests can answer the prompts with a mock, such as the one in the
+// interacttest package.
+type Interactor interface {
+	Confirm(message string, def ConfirmDefault) (bool, error)
+	Prompt(message string, checks ...InputCheck) (string, error)
+	PromptOptional(message, defaultOption string, checks ...InputCheck) (string, error)
+	PromptAndRetry(message string, checks ...InputCheck) (string, error)
+	PromptOptionalAndRetry(message, defaultOption string, checks ...InputCheck) (string, error)
+}
+
+var _ Interactor = Actor{}
+
+// WithID returns a copy of the Actor that identifies its prompts with the
+// given ID, so that they can be told apart by frontends and mocks even if
+// their messages change
+func (a Actor) WithID(id string) Actor {
+	a.id = id
+	return a
+}
+
+// WithID returns an Interactor that identifies its prompts with the given ID.
+// If i is neither an Actor nor has a WithID method returning an Interactor, i
+// is returned as is.
+func WithID(i Interactor, id string) Interactor {
+	switch i := i.(type) {
+	case Actor:
+		return i.WithID(id)
+	case interface{ WithID(string) Interactor }:
+		return i.WithID(id)
+	}
+	return i
+}
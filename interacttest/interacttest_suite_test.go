@@ -0,0 +1,13 @@
+package interacttest_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestInteracttest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Interacttest Suite")
+}
@@ -0,0 +1,269 @@
+// Package interacttest provides a mock interact.Interactor for testing code
+// that interacts with the user
+package interacttest
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/deiwin/interact"
+)
+
+// ErrNoAnswer is returned by a Mock when it's asked a prompt it has no answers
+// left for
+var ErrNoAnswer = errors.New("No answer for the prompt")
+
+// A Call is a prompt a Mock has been asked
+type Call struct {
+	// Method is the name of the Interactor method that was called
+	Method  string
+	ID      string
+	Message string
+	// Default is the default option of PromptOptional and
+	// PromptOptionalAndRetry, or "y" or "n" for Confirm
+	Default string
+	// Answers are the scripted answers that were used, more than one if a
+	// check failed and the prompt was retried
+	Answers []string
+	Err     error
+}
+
+// TestingT is the subset of testing.T (and GinkgoT()) used by the assertion
+// helpers of a Mock
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// A Mock is an interact.Interactor that answers prompts with scripted answers
+// instead of reading them from the user, and records the prompts it's asked.
+// Answers are keyed by the ID of the prompt (see interact.WithID) or by its
+// message; if both have answers, the ID takes precedence. A Mock can be used
+// from multiple goroutines at once.
+type Mock struct {
+	*state
+	id string
+}
+
+type state struct {
+	mu      sync.Mutex
+	answers map[string][]answer
+	calls   []Call
+}
+
+type answer struct {
+	value string
+	err   error
+}
+
+var _ interact.Interactor = &Mock{}
+
+// NewMock creates a Mock without any answers
+func NewMock() *Mock {
+	return &Mock{state: &state{answers: make(map[string][]answer)}}
+}
+
+// Answer adds answers for the prompt with the given ID or message. Each of
+// them is used once, in order. Confirm accepts "y", "yes", "n" and "no", and
+// an empty answer selects the default of Confirm and PromptOptional.
+func (m *Mock) Answer(key string, answers ...string) *Mock {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, value := range answers {
+		m.answers[key] = append(m.answers[key], answer{value: value})
+	}
+	return m
+}
+
+// Fail adds an answer for the prompt with the given ID or message that makes
+// the prompt return err, e.g. interact.ErrInterrupted
+func (m *Mock) Fail(key string, err error) *Mock {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.answers[key] = append(m.answers[key], answer{err: err})
+	return m
+}
+
+// WithID returns a Mock that shares the answers and calls of m, but
+// identifies its prompts with the given ID
+func (m *Mock) WithID(id string) interact.Interactor {
+	return &Mock{state: m.state, id: id}
+}
+
+// Calls returns the prompts the Mock has been asked, in order
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// Asked returns the number of times the prompt with the given ID or message
+// has been asked
+func (m *Mock) Asked(key string) int {
+	var n int
+	for _, call := range m.Calls() {
+		if call.ID == key || call.Message == key {
+			n++
+		}
+	}
+	return n
+}
+
+// Unused returns the keys of the answers that haven't been used, sorted
+func (m *Mock) Unused() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for key, answers := range m.answers {
+		if len(answers) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// AssertAsked fails the test unless the prompt with the given ID or message
+// has been asked
+func (m *Mock) AssertAsked(t TestingT, key string) {
+	t.Helper()
+	if m.Asked(key) == 0 {
+		t.Errorf("Expected %q to have been asked", key)
+	}
+}
+
+// AssertNotAsked fails the test if the prompt with the given ID or message
+// has been asked
+func (m *Mock) AssertNotAsked(t TestingT, key string) {
+	t.Helper()
+	if n := m.Asked(key); n > 0 {
+		t.Errorf("Expected %q not to have been asked, but it was asked %d times", key, n)
+	}
+}
+
+// AssertAllAnswered fails the test if any of the answers haven't been used
+func (m *Mock) AssertAllAnswered(t TestingT) {
+	t.Helper()
+	if unused := m.Unused(); len(unused) > 0 {
+		t.Errorf("Expected all answers to have been used, but %s have not", strings.Join(quote(unused), ", "))
+	}
+}
+
+// Confirm answers with the next answer for the prompt
+func (m *Mock) Confirm(message string, def interact.ConfirmDefault) (bool, error) {
+	call := Call{Method: "Confirm", ID: m.id, Message: message}
+	switch def {
+	case interact.ConfirmDefaultToYes:
+		call.Default = "y"
+	case interact.ConfirmDefaultToNo:
+		call.Default = "n"
+	}
+	value, err := m.next(&call)
+	if err == nil {
+		if value == "" {
+			value = call.Default
+		}
+		switch value {
+		case "y", "yes":
+			return true, m.record(call, nil)
+		case "n", "no":
+			return false, m.record(call, nil)
+		}
+		err = fmt.Errorf("Invalid answer %q for Confirm", value)
+	}
+	return false, m.record(call, err)
+}
+
+// Prompt answers with the next answer for the prompt and returns the error of
+// the first check that fails
+func (m *Mock) Prompt(message string, checks ...interact.InputCheck) (string, error) {
+	return m.prompt(Call{Method: "Prompt", ID: m.id, Message: message}, false, checks)
+}
+
+// PromptOptional works like Prompt, but an empty answer selects the default
+func (m *Mock) PromptOptional(message, defaultOption string, checks ...interact.InputCheck) (string, error) {
+	return m.prompt(Call{Method: "PromptOptional", ID: m.id, Message: message, Default: defaultOption}, false, checks)
+}
+
+// PromptAndRetry works like Prompt, but if a check fails, the next answer for
+// the prompt is tried. If there are no answers left, interact.ErrCanceled is
+// returned, just like when the user declines to try again.
+func (m *Mock) PromptAndRetry(message string, checks ...interact.InputCheck) (string, error) {
+	return m.prompt(Call{Method: "PromptAndRetry", ID: m.id, Message: message}, true, checks)
+}
+
+// PromptOptionalAndRetry works like PromptAndRetry, but an empty answer
+// selects the default
+func (m *Mock) PromptOptionalAndRetry(message, defaultOption string, checks ...interact.InputCheck) (string, error) {
+	return m.prompt(Call{Method: "PromptOptionalAndRetry", ID: m.id, Message: message, Default: defaultOption}, true, checks)
+}
+
+func (m *Mock) prompt(call Call, retry bool, checks []interact.InputCheck) (string, error) {
+	optional := call.Method == "PromptOptional" || call.Method == "PromptOptionalAndRetry"
+	for {
+		value, err := m.next(&call)
+		if err != nil {
+			if retry && len(call.Answers) > 0 && err == ErrNoAnswer {
+				err = interact.ErrCanceled
+			}
+			return "", m.record(call, err)
+		}
+		if value == "" && optional {
+			return call.Default, m.record(call, nil)
+		}
+		if err = runChecks(value, checks); err == nil {
+			return value, m.record(call, nil)
+		} else if !retry {
+			return "", m.record(call, err)
+		}
+	}
+}
+
+// next takes the next answer for the call, preferring the answers keyed by
+// its ID
+func (m *Mock) next(call *Call) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := call.Message
+	if call.ID != "" && len(m.answers[call.ID]) > 0 {
+		key = call.ID
+	}
+	answers := m.answers[key]
+	if len(answers) == 0 {
+		return "", ErrNoAnswer
+	}
+	m.answers[key] = answers[1:]
+	call.Answers = append(call.Answers, answers[0].value)
+	return answers[0].value, answers[0].err
+}
+
+// record records the call with the error it returns
+func (m *Mock) record(call Call, err error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	call.Err = err
+	m.calls = append(m.calls, call)
+	return err
+}
+
+// runChecks returns the error of the first check that fails. Warnings are
+// accepted, as if the user chose to continue anyway.
+func runChecks(input string, checks []interact.InputCheck) error {
+	for _, check := range checks {
+		if err := check(input); err != nil && !errors.As(err, new(interact.Warning)) {
+			return err
+		}
+	}
+	return nil
+}
+
+func quote(strs []string) []string {
+	quoted := make([]string, len(strs))
+	for i, s := range strs {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return quoted
+}
@@ -0,0 +1,152 @@
+package interacttest_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/deiwin/interact"
+	"github.com/deiwin/interact/interacttest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeT records the failures of the assertion helpers
+type fakeT struct {
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+var _ = Describe("Mock", func() {
+	var mock *interacttest.Mock
+
+	BeforeEach(func() {
+		mock = interacttest.NewMock()
+	})
+
+	Describe("Confirm", func() {
+		It("should answer with the scripted answers in order", func() {
+			mock.Answer("Continue?", "y", "no")
+			Expect(mock.Confirm("Continue?", interact.ConfirmNoDefault)).To(BeTrue())
+			Expect(mock.Confirm("Continue?", interact.ConfirmNoDefault)).To(BeFalse())
+		})
+
+		It("should use the default for an empty answer", func() {
+			mock.Answer("Continue?", "")
+			Expect(mock.Confirm("Continue?", interact.ConfirmDefaultToYes)).To(BeTrue())
+		})
+
+		It("should return an error for an invalid answer", func() {
+			mock.Answer("Continue?", "maybe")
+			_, err := mock.Confirm("Continue?", interact.ConfirmNoDefault)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Prompt", func() {
+		It("should return ErrNoAnswer without an answer", func() {
+			_, err := mock.Prompt("Name")
+			Expect(err).To(Equal(interacttest.ErrNoAnswer))
+		})
+
+		It("should return scripted errors", func() {
+			mock.Fail("Name", interact.ErrInterrupted)
+			_, err := mock.Prompt("Name")
+			Expect(err).To(Equal(interact.ErrInterrupted))
+		})
+
+		It("should return the error of a failing check", func() {
+			checkErr := errors.New("Too short!")
+			mock.Answer("Name", "a")
+			_, err := mock.Prompt("Name", func(string) error { return checkErr })
+			Expect(err).To(Equal(checkErr))
+		})
+
+		It("should accept warnings", func() {
+			mock.Answer("Name", "a")
+			Expect(mock.Prompt("Name", func(string) error { return interact.Warning("Short") })).To(Equal("a"))
+		})
+
+		It("should use the default of PromptOptional for an empty answer", func() {
+			mock.Answer("Name", "")
+			Expect(mock.PromptOptional("Name", "Bob")).To(Equal("Bob"))
+		})
+
+		It("should use an empty default of PromptOptional without running the checks", func() {
+			mock.Answer("Name", "")
+			Expect(mock.PromptOptional("Name", "", func(string) error { return errors.New("Empty!") })).To(Equal(""))
+		})
+
+		It("should run the checks on an empty answer to Prompt", func() {
+			mock.Answer("Name", "")
+			_, err := mock.Prompt("Name", func(string) error { return errors.New("Empty!") })
+			Expect(err).To(MatchError("Empty!"))
+		})
+
+		It("should prefer answers keyed by ID", func() {
+			mock.Answer("Name", "by message").Answer("name", "by ID")
+			i := interact.WithID(mock, "name")
+			Expect(i.Prompt("Name")).To(Equal("by ID"))
+			Expect(i.Prompt("Name")).To(Equal("by message"))
+			Expect(mock.Asked("name")).To(Equal(2))
+		})
+	})
+
+	Describe("PromptAndRetry", func() {
+		check := func(input string) error {
+			if input != "ok" {
+				return errors.New("Not ok!")
+			}
+			return nil
+		}
+
+		It("should try the next answer after a failing check", func() {
+			mock.Answer("Status", "bad", "ok")
+			Expect(mock.PromptAndRetry("Status", check)).To(Equal("ok"))
+			Expect(mock.Calls()).To(ConsistOf(interacttest.Call{
+				Method:  "PromptAndRetry",
+				Message: "Status",
+				Answers: []string{"bad", "ok"},
+			}))
+		})
+
+		It("should return ErrCanceled once the answers run out", func() {
+			mock.Answer("Status", "bad")
+			_, err := mock.PromptOptionalAndRetry("Status", "ok", check)
+			Expect(err).To(Equal(interact.ErrCanceled))
+		})
+	})
+
+	Describe("assertions", func() {
+		var t *fakeT
+
+		BeforeEach(func() {
+			t = &fakeT{}
+			mock.Answer("Name", "Alice").Answer("Continue?", "y")
+			mock.Prompt("Name")
+		})
+
+		It("should check whether a prompt was asked", func() {
+			mock.AssertAsked(t, "Name")
+			mock.AssertNotAsked(t, "Continue?")
+			Expect(t.errors).To(BeEmpty())
+			mock.AssertAsked(t, "Continue?")
+			mock.AssertNotAsked(t, "Name")
+			Expect(t.errors).To(HaveLen(2))
+		})
+
+		It("should list the unused answers in order", func() {
+			mock.Answer("b", "1").Answer("c", "1").Answer("a", "1")
+			Expect(mock.Unused()).To(Equal([]string{"Continue?", "a", "b", "c"}))
+		})
+
+		It("should check whether all answers were used", func() {
+			mock.AssertAllAnswered(t)
+			Expect(t.errors).To(ConsistOf(ContainSubstring(`"Continue?"`)))
+		})
+	})
+})
@@ -0,0 +1,186 @@
+package interact
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// kindOutput is the kind of the JSON objects that carry anything else the
+// Actor writes, such as warnings
+const kindOutput questionKind = "output"
+
+// WithJSON returns a copy of the Actor that talks to another program instead
+// of a person: every prompt is written as a single line JSON object and the
+// answer is read as a single line JSON object. A prompt looks like
+//
+//	{"id":"1","kind":"input","message":"Name","default":"Bob","error":"Please enter a name!"}
+//
+// where kind is one of input, secret, confirm, select, multiselect or choice,
+// options lists the options of select and multiselect prompts or the keys of
+// choice prompts, descriptions describes the keys of choice prompts, clear is
+// the token set with WithClearToken for prompts with a default option, help is
+// the text set with WithHelp, and error is the reason the previous answer was
+// rejected. The id is the one set with WithID, or a sequence number otherwise.
+// The answer must repeat the id and has a value that depends on the kind:
+//
+//	{"id":"1","value":"Alice"}      a string for input and secret
+//	{"id":"2","value":true}         a boolean or null (the default) for confirm
+//	{"id":"3","value":2}            the index of an option for select
+//	{"id":"4","value":[0,2]}        the indexes of options for multiselect
+//	{"id":"5","value":"q"}          the key of a choice for choice
+//
+// Instead of a value, the answer can cancel the prompt, which then returns
+// ErrCanceled, just like when a person declines to try again:
+//
+//	{"id":"6","cancel":true}
+//
+// If the answer is invalid, the prompt is written again with the error set.
+// Anything else the Actor writes is written as an object with the kind output
+// and the text as the message.
+func (a Actor) WithJSON() Actor {
+	p := &jsonProtocol{rd: a.rd, w: a.w, maxLength: a.maxLength}
+	a.w = p
+	a.ui = p
+	return a
+}
+
+type jsonProtocol struct {
+	mu        sync.Mutex
+	rd        *bufio.Reader
+	w         io.Writer
+	maxLength int
+	lastID    int
+}
+
+type jsonQuestion struct {
+	ID           string       `json:"id,omitempty"`
+	Kind         questionKind `json:"kind"`
+	Message      string       `json:"message"`
+	Default      string       `json:"default,omitempty"`
+	Clear        string       `json:"clear,omitempty"`
+	Options      []string     `json:"options,omitempty"`
+	Descriptions []string     `json:"descriptions,omitempty"`
+	Help         string       `json:"help,omitempty"`
+	Error        string       `json:"error,omitempty"`
+}
+
+type jsonAnswer struct {
+	ID     string          `json:"id"`
+	Value  json.RawMessage `json:"value"`
+	Cancel bool            `json:"cancel"`
+}
+
+func (p *jsonProtocol) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	text := strings.TrimSuffix(string(b), "\n")
+	if err := p.write(jsonQuestion{Kind: kindOutput, Message: text}); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (p *jsonProtocol) ask(q question) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if q.ID == "" {
+		p.lastID++
+		q.ID = strconv.Itoa(p.lastID)
+	}
+	for {
+		err := p.write(jsonQuestion{q.ID, q.Kind, q.Message, q.Default, q.ClearToken, q.Options, q.Descriptions, q.Help, q.Error})
+		if err != nil {
+			return "", err
+		}
+		line, err := readBoundedLine(p.rd, p.maxLength)
+		if err == io.EOF && line == "" {
+			return "", ErrEndOfInput
+		} else if isInvalidInput(err) {
+			q.Error = err.Error()
+			continue
+		} else if err != nil && err != io.EOF {
+			return "", err
+		}
+		answer, err := q.parseJSON(line)
+		if err == nil || err == ErrCanceled {
+			return answer, err
+		}
+		q.Error = err.Error()
+	}
+}
+
+func (p *jsonProtocol) write(q jsonQuestion) error {
+	b, err := json.Marshal(q)
+	if err != nil {
+		return err
+	}
+	_, err = p.w.Write(append(b, '\n'))
+	return err
+}
+
+// parseJSON converts an answer to the question from JSON to the form the
+// frontend interface expects. ErrCanceled is returned if the answer cancels
+// the question.
+func (q question) parseJSON(line string) (string, error) {
+	var answer jsonAnswer
+	if err := json.Unmarshal([]byte(line), &answer); err != nil {
+		return "", errors.New("The answer is not a valid JSON object!")
+	} else if answer.ID != q.ID {
+		return "", fmt.Errorf("Expected an answer with the id %q!", q.ID)
+	} else if answer.Cancel {
+		return "", ErrCanceled
+	}
+	switch q.Kind {
+	case kindConfirm:
+		var confirmed *bool
+		if err := json.Unmarshal(answer.Value, &confirmed); err != nil {
+			return "", errors.New("The value must be true, false or null!")
+		} else if confirmed == nil {
+			return "", nil
+		} else if *confirmed {
+			return "y", nil
+		}
+		return "n", nil
+	case kindSelect:
+		var i int
+		if err := json.Unmarshal(answer.Value, &i); err != nil || i < 0 || i >= len(q.Options) {
+			return "", fmt.Errorf("The value must be a number between 0 and %d!", len(q.Options)-1)
+		}
+		return strconv.Itoa(i), nil
+	case kindMultiSelect:
+		var indexes []int
+		err := json.Unmarshal(answer.Value, &indexes)
+		fields := make([]string, len(indexes))
+		for j, i := range indexes {
+			if i < 0 || i >= len(q.Options) {
+				err = errors.New("index out of range")
+			}
+			fields[j] = strconv.Itoa(i)
+		}
+		if err != nil {
+			return "", fmt.Errorf("The value must be a list of numbers between 0 and %d!", len(q.Options)-1)
+		}
+		return strings.Join(fields, ","), nil
+	case kindChoice:
+		var key string
+		if err := json.Unmarshal(answer.Value, &key); err == nil {
+			for _, option := range q.Options {
+				if key == option {
+					return key, nil
+				}
+			}
+		}
+		return "", fmt.Errorf("The value must be one of %s!", orList(q.Options))
+	}
+	var value string
+	if err := json.Unmarshal(answer.Value, &value); err != nil {
+		return "", errors.New("The value must be a string!")
+	}
+	return value, nil
+}
@@ -0,0 +1,165 @@
+package interact_test
+
+import (
+	"errors"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("WithJSON", func() {
+	var jsonActor interact.Actor
+
+	JustBeforeEach(func() {
+		jsonActor = actor.WithJSON()
+	})
+
+	Context("with a string answer", func() {
+		BeforeEach(func() {
+			userInput = `{"id":"1","value":" Alice "}` + "\n"
+		})
+
+		It("should write the prompt as JSON and read the answer", func() {
+			Expect(jsonActor.PromptOptional("Name", "Bob")).To(Equal("Alice"))
+			Eventually(output).Should(gbytes.Say(`^{"id":"1","kind":"input","message":"Name","default":"Bob"}\n$`))
+		})
+
+		It("should use the ID set with WithID", func() {
+			_, err := jsonActor.WithID("name").Prompt("Name")
+			Expect(err).To(HaveOccurred())
+			Eventually(output).Should(gbytes.Say(`{"id":"name","kind":"input","message":"Name"}\n`))
+			Eventually(output).Should(gbytes.Say(`{"id":"name","kind":"input","message":"Name","error":"Expected an answer with the id \\"name\\"!"}\n`))
+		})
+	})
+
+	Context("with a failing check", func() {
+		BeforeEach(func() {
+			userInput = `{"id":"1","value":"a"}` + "\n" + `{"id":"2","value":"abc"}` + "\n"
+		})
+
+		It("should ask again with the error", func() {
+			input, err := jsonActor.PromptAndRetry("Name", func(input string) error {
+				if len(input) < 3 {
+					return errors.New("Too short!")
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("abc"))
+			Eventually(output).Should(gbytes.Say(`{"id":"1","kind":"input","message":"Name"}\n`))
+			Eventually(output).Should(gbytes.Say(`{"id":"2","kind":"input","message":"Name","error":"Too short!"}\n`))
+		})
+	})
+
+	Context("with an invalid answer", func() {
+		BeforeEach(func() {
+			userInput = "yes\n" + `{"id":"1","value":"yes"}` + "\n" + `{"id":"1","value":true}` + "\n"
+		})
+
+		It("should ask again until the answer is valid", func() {
+			Expect(jsonActor.Confirm("Continue?", interact.ConfirmDefaultToNo)).To(BeTrue())
+			Eventually(output).Should(gbytes.Say(`{"id":"1","kind":"confirm","message":"Continue\?","default":"n"}\n`))
+			Eventually(output).Should(gbytes.Say(`"error":"The answer is not a valid JSON object!"}\n`))
+			Eventually(output).Should(gbytes.Say(`"error":"The value must be true, false or null!"}\n`))
+		})
+	})
+
+	Context("with a null confirmation", func() {
+		BeforeEach(func() {
+			userInput = `{"id":"1","value":null}` + "\n"
+		})
+
+		It("should use the default", func() {
+			Expect(jsonActor.Confirm("Continue?", interact.ConfirmDefaultToYes)).To(BeTrue())
+		})
+	})
+
+	Context("with selections", func() {
+		BeforeEach(func() {
+			userInput = `{"id":"1","value":3}` + "\n" + `{"id":"1","value":1}` + "\n" + `{"id":"2","value":[0,2]}` + "\n"
+		})
+
+		It("should return the selected indexes", func() {
+			options := []string{"red", "green", "blue"}
+			Expect(jsonActor.Select("Color", options)).To(Equal(1))
+			Expect(jsonActor.MultiSelect("Colors", options)).To(Equal([]int{0, 2}))
+			Eventually(output).Should(gbytes.Say(`{"id":"1","kind":"select","message":"Color","options":\["red","green","blue"\]}\n`))
+			Eventually(output).Should(gbytes.Say(`"error":"The value must be a number between 0 and 2!"}\n`))
+		})
+	})
+
+	Context("with other output", func() {
+		BeforeEach(func() {
+			userInput = `{"id":"1","value":"a"}` + "\n" + `{"id":"2","value":""}` + "\n"
+		})
+
+		It("should write it as JSON", func() {
+			Expect(jsonActor.PromptList("Hosts")).To(Equal([]string{"a"}))
+			Eventually(output).Should(gbytes.Say(`{"kind":"output","message":"Hosts \(enter an empty line to finish or - to remove the last item\)"}\n`))
+		})
+	})
+
+	Context("with a choice", func() {
+		BeforeEach(func() {
+			userInput = `{"id":"1","value":"x"}` + "\n" + `{"id":"1","value":"s"}` + "\n"
+		})
+
+		It("should return the key", func() {
+			choices := []interact.Choice{{Key: "a", Description: "accept"}, {Key: "s", Description: "skip"}}
+			Expect(jsonActor.Choose("Apply?", choices)).To(Equal("s"))
+			Eventually(output).Should(gbytes.Say(`{"id":"1","kind":"choice","message":"Apply\?","options":\["a","s"\],"descriptions":\["accept","skip"\]}\n`))
+			Eventually(output).Should(gbytes.Say(`"error":"The value must be one of a or s!"}\n`))
+		})
+	})
+
+	Context("with a progress bar running", func() {
+		BeforeEach(func() {
+			userInput = `{"id":"1","value":"a"}` + "\n" + `{"id":"2","value":true}` + "\n" + `{"id":"3","value":0}` + "\n" + `{"id":"4","value":"s"}` + "\n"
+		})
+
+		It("should stop it before every kind of prompt", func() {
+			ask := []func() error{
+				func() error { _, err := jsonActor.Prompt("Name"); return err },
+				func() error { _, err := jsonActor.Confirm("Continue?", interact.ConfirmDefaultToNo); return err },
+				func() error { _, err := jsonActor.Select("Color", []string{"red"}); return err },
+				func() error {
+					_, err := jsonActor.Choose("Apply?", []interact.Choice{{Key: "s", Description: "skip"}})
+					return err
+				},
+			}
+			for _, f := range ask {
+				progress := jsonActor.Progress(10)
+				Expect(f()).To(Succeed())
+				progress.Set(10)
+			}
+			Expect(string(output.Contents())).NotTo(ContainSubstring("10/10"))
+		})
+	})
+
+	Context("with a canceled prompt", func() {
+		BeforeEach(func() {
+			userInput = `{"id":"1","value":"a"}` + "\n" + `{"id":"2","cancel":true}` + "\n"
+		})
+
+		It("should return ErrCanceled instead of asking again", func() {
+			_, err := jsonActor.PromptAndRetry("Name", func(input string) error {
+				return errors.New("Too short!")
+			})
+			Expect(err).To(Equal(interact.ErrCanceled))
+			Eventually(output).Should(gbytes.Say(`{"id":"2","kind":"input","message":"Name","error":"Too short!"}\n`))
+		})
+	})
+
+	Context("with the input ending", func() {
+		BeforeEach(func() {
+			userInput = ""
+		})
+
+		It("should return ErrEndOfInput", func() {
+			_, err := jsonActor.Prompt("Name")
+			Expect(err).To(Equal(interact.ErrEndOfInput))
+		})
+	})
+})
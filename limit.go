@@ -0,0 +1,114 @@
+package interact
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// defaultMaxLength is the maximum length of an answer in bytes, unless
+	// set with WithMaxLength
+	defaultMaxLength = 4096
+	errInvalidUTF8   = invalidInputError("The answer is not valid UTF-8!")
+	errControlChar   = invalidInputError("The answer can not contain control characters!")
+)
+
+// invalidInputError is returned when an answer is rejected before any checks
+// are performed, because it's too long or contains characters that aren't
+// allowed
+type invalidInputError string
+
+func (e invalidInputError) Error() string {
+	return string(e)
+}
+
+func errTooLong(max int) error {
+	return invalidInputError(fmt.Sprintf("The answer can be at most %d bytes long!", max))
+}
+
+func isInvalidInput(err error) bool {
+	var invalid invalidInputError
+	return errors.As(err, &invalid)
+}
+
+// WithMaxLength returns a copy of the Actor that rejects answers longer than
+// max bytes (4096 by default). The rest of an answer that's too long is read
+// and discarded without keeping it in memory. Zero or less means no limit. In
+// JSON mode, the limit applies to the whole line of the answer.
+func (a Actor) WithMaxLength(max int) Actor {
+	a.maxLength = max
+	return a
+}
+
+// readBoundedLine reads a line like bufio.Reader.ReadString('\n'), but only
+// keeps up to max bytes (not counting the line terminator) in memory. If the
+// line is longer, the rest of it is discarded and an error is returned.
+func readBoundedLine(rd *bufio.Reader, max int) (string, error) {
+	var (
+		line    []byte
+		tooLong bool
+		err     error
+	)
+	for {
+		var chunk []byte
+		chunk, err = rd.ReadSlice('\n')
+		if !tooLong {
+			line = append(line, chunk...)
+			tooLong = max > 0 && len(line) > max+len("\r\n")
+		}
+		if err != bufio.ErrBufferFull {
+			break
+		}
+	}
+	if err != nil && err != io.EOF {
+		return "", err
+	} else if tooLong || max > 0 && len(TrimNewline(string(line))) > max {
+		return "", errTooLong(max)
+	}
+	return string(line), err
+}
+
+// validateInput rejects answers that aren't valid UTF-8 or contain control
+// characters other than tabs
+func validateInput(input string) error {
+	if !utf8.ValidString(input) {
+		return errInvalidUTF8
+	}
+	for _, r := range input {
+		if r != '\t' && unicode.IsControl(r) {
+			return errControlChar
+		}
+	}
+	return nil
+}
+
+// validateAnswer checks the length and the characters of an answer that
+// didn't come from readLine, e.g. from a frontend
+func (a Actor) validateAnswer(input string) error {
+	if a.maxLength > 0 && len(input) > a.maxLength {
+		return errTooLong(a.maxLength)
+	}
+	return validateInput(input)
+}
+
+// fits reports whether r can be appended to an answer being typed in raw mode
+// without making it too long
+func (a Actor) fits(input string, r rune) bool {
+	return a.maxLength <= 0 || len(input)+utf8.RuneLen(r) <= a.maxLength
+}
+
+// promptValid prompts until the answer passes validateInput and the length
+// limit, displaying the error for every answer that doesn't
+func (a Actor) promptValid(message string) (string, error) {
+	for {
+		input, err := a.prompt(message)
+		if !isInvalidInput(err) {
+			return input, err
+		}
+		fmt.Fprintln(a.w, err)
+	}
+}
@@ -0,0 +1,98 @@
+package interact_test
+
+import (
+	"strings"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Input limits", func() {
+	Context("with an answer over the default limit", func() {
+		BeforeEach(func() {
+			userInput = strings.Repeat("a", 5000) + "\ny\nshort\n"
+		})
+
+		It("should reject it through the retry flow", func() {
+			Expect(actor.PromptAndRetry("Name")).To(Equal("short"))
+			Eventually(output).Should(gbytes.Say(`The answer can be at most 4096 bytes long!\nDo you want to try again\?`))
+		})
+	})
+
+	Context("with a custom limit", func() {
+		BeforeEach(func() {
+			userInput = strings.Repeat("a", 1<<20) + "\nabcde\r\n"
+		})
+
+		It("should discard the rest of the line", func() {
+			limited := actor.WithMaxLength(5)
+			_, err := limited.Prompt("Name")
+			Expect(err).To(MatchError("The answer can be at most 5 bytes long!"))
+			Expect(limited.Prompt("Name")).To(Equal("abcde"))
+		})
+
+		It("should allow disabling the limit", func() {
+			Expect(actor.WithMaxLength(0).Prompt("Name")).To(HaveLen(1 << 20))
+		})
+	})
+
+	Context("with a partial line over the limit at the end of the input", func() {
+		BeforeEach(func() {
+			userInput = "abcdef"
+		})
+
+		It("should reject it", func() {
+			_, err := actor.WithMaxLength(5).Prompt("Name")
+			Expect(err).To(MatchError("The answer can be at most 5 bytes long!"))
+		})
+	})
+
+	Context("with control characters", func() {
+		BeforeEach(func() {
+			userInput = "a\x00b\na\x1b[31mb\na\tb\n"
+		})
+
+		It("should reject all but tabs", func() {
+			_, err := actor.Prompt("Name")
+			Expect(err).To(MatchError("The answer can not contain control characters!"))
+			_, err = actor.Prompt("Name")
+			Expect(err).To(MatchError("The answer can not contain control characters!"))
+			Expect(actor.Prompt("Name")).To(Equal("a\tb"))
+		})
+	})
+
+	Context("with invalid UTF-8", func() {
+		BeforeEach(func() {
+			userInput = "a\xffb\n"
+		})
+
+		It("should reject it", func() {
+			_, err := actor.Prompt("Name")
+			Expect(err).To(MatchError("The answer is not valid UTF-8!"))
+		})
+	})
+
+	Context("with an invalid answer to Confirm", func() {
+		BeforeEach(func() {
+			userInput = "\x00\ny\n"
+		})
+
+		It("should ask again", func() {
+			Expect(actor.Confirm("Continue?", interact.ConfirmNoDefault)).To(BeTrue())
+			Eventually(output).Should(gbytes.Say(`Continue\? \[y/n\]: The answer can not contain control characters!\nContinue\? \[y/n\]: `))
+		})
+	})
+
+	Context("with an invalid answer in JSON mode", func() {
+		BeforeEach(func() {
+			userInput = `{"id":"1","value":"a\u0000b"}` + "\n"
+		})
+
+		It("should reject the value", func() {
+			_, err := actor.WithJSON().Prompt("Name")
+			Expect(err).To(MatchError("The answer can not contain control characters!"))
+		})
+	})
+})
@@ -0,0 +1,125 @@
+package interact
+
+import (
+	"fmt"
+	"strings"
+)
+
+// listRemoveToken is the input that removes the last item in PromptList
+const listRemoveToken = "-"
+
+// ListCheck specifies the function signature for a check on all of the inputs
+// collected by PromptList
+type ListCheck func([]string) error
+
+// MinItems returns a ListCheck that requires at least n items
+func MinItems(n int) ListCheck {
+	return func(items []string) error {
+		if len(items) < n {
+			return fmt.Errorf("Please enter at least %d item(s)!", n)
+		}
+		return nil
+	}
+}
+
+// MaxItems returns a ListCheck that allows at most n items
+func MaxItems(n int) ListCheck {
+	return func(items []string) error {
+		if len(items) > n {
+			return fmt.Errorf("Please enter at most %d item(s)!", n)
+		}
+		return nil
+	}
+}
+
+// UniqueItems is a ListCheck that requires all items to be different
+func UniqueItems(items []string) error {
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if seen[item] {
+			return fmt.Errorf("%s was entered more than once!", Sanitize(item))
+		}
+		seen[item] = true
+	}
+	return nil
+}
+
+// WithListChecks returns a copy of the Actor that performs the given checks on
+// the whole list of inputs collected by PromptList
+func (a Actor) WithListChecks(checks ...ListCheck) Actor {
+	a.listChecks = append([]ListCheck{}, checks...)
+	return a
+}
+
+// PromptList asks the user for items until they enter an empty line, and
+// performs the list of added checks on each item. If a check fails, the error
+// is displayed and the user is asked for the same item again. Entering "-"
+// removes the last item. Once the user is done, the checks added with
+// WithListChecks are performed on the whole list. If any of them fail, the
+// error is displayed and the user is asked if they want to keep editing the
+// list. If they don't, an error is returned.
+func (a Actor) PromptList(message string, checks ...InputCheck) ([]string, error) {
+	a, unlock := a.lock()
+	defer unlock()
+	a.status.clear()
+	message = a.sanitize(message)
+	fmt.Fprintf(a.w, "%s (enter an empty line to finish or %s to remove the last item)\n", message, listRemoveToken)
+	items := []string{}
+	for {
+		input, err := a.ask(question{Kind: kindInput, Message: fmt.Sprintf("%s #%d", message, len(items)+1)})
+		if isInvalidInput(err) {
+			fmt.Fprintln(a.w, err)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		switch strings.TrimSpace(input) {
+		case "":
+			err = a.runListChecks(items)
+			if err == nil {
+				return items, nil
+			} else if err = a.confirmRetry(err); err != nil {
+				return nil, err
+			}
+		case listRemoveToken:
+			if len(items) > 0 {
+				fmt.Fprintf(a.w, "Removed %s\n", Sanitize(items[len(items)-1]))
+				items = items[:len(items)-1]
+			}
+		default:
+			item, err := a.checkInput(a.transform(input), checks...)
+			if isWarning(err) {
+				continue
+			} else if isFinal(err) {
+				return nil, err
+			} else if err != nil {
+				fmt.Fprintln(a.w, err)
+				continue
+			}
+			items = append(items, item)
+		}
+	}
+}
+
+// runListChecks performs the checks added with WithListChecks on the items,
+// stopping at the first failure unless the Actor was created with
+// WithAllChecks
+func (a Actor) runListChecks(items []string) error {
+	var errs CheckErrors
+	for _, check := range a.listChecks {
+		if err := check(items); err != nil {
+			if !a.allChecks {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return errs
+	}
+}
@@ -0,0 +1,153 @@
+package interact_test
+
+import (
+	"errors"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("PromptList", func() {
+	var message = "Hostname"
+
+	Context("with the user entering items", func() {
+		BeforeEach(func() {
+			userInput = " a.example.com \nb.example.com\n\n"
+		})
+
+		It("should return the trimmed items", func() {
+			items, err := actor.PromptList(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(items).To(Equal([]string{"a.example.com", "b.example.com"}))
+		})
+
+		It("should number the prompts", func() {
+			actor.PromptList(message)
+			Eventually(output).Should(gbytes.Say(`Hostname \(enter an empty line to finish or - to remove the last item\)\n`))
+			Eventually(output).Should(gbytes.Say(`Hostname #1: Hostname #2: Hostname #3: `))
+		})
+	})
+
+	Context("with the user entering nothing", func() {
+		BeforeEach(func() {
+			userInput = "\n"
+		})
+
+		It("should return an empty list", func() {
+			items, err := actor.PromptList(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(items).To(BeEmpty())
+		})
+	})
+
+	Context("with the user removing the last item", func() {
+		BeforeEach(func() {
+			userInput = "a\nb\n-\nc\n\n"
+		})
+
+		It("should not return the removed item", func() {
+			items, err := actor.PromptList(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(items).To(Equal([]string{"a", "c"}))
+			Eventually(output).Should(gbytes.Say(`Hostname #3: Removed b\nHostname #2: `))
+		})
+	})
+
+	Context("with an item failing a check", func() {
+		BeforeEach(func() {
+			userInput = "bad\ngood\n\n"
+		})
+
+		It("should ask for the same item again", func() {
+			items, err := actor.PromptList(message, func(input string) error {
+				if input == "bad" {
+					return errors.New("Bad hostname!")
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(items).To(Equal([]string{"good"}))
+			Eventually(output).Should(gbytes.Say(`Hostname #1: Bad hostname!\nHostname #1: `))
+		})
+	})
+
+	Context("with the input ending while suggesting an item", func() {
+		BeforeEach(func() {
+			userInput = "alph\n"
+		})
+
+		It("should return the error instead of asking for the item again", func() {
+			_, err := actor.PromptList(message, interact.OneOf("alpha", "beta"))
+			Expect(err).To(Equal(interact.ErrEndOfInput))
+			Eventually(output).Should(gbytes.Say(`Did you mean 'alpha'\? \[Y/n\]: `))
+			Expect(string(output.Contents())).NotTo(ContainSubstring("End of input"))
+		})
+	})
+
+	Context("with an invalid item", func() {
+		BeforeEach(func() {
+			userInput = "b\x00d\ngood\n\n"
+		})
+
+		It("should ask for the same item again", func() {
+			items, err := actor.PromptList(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(items).To(Equal([]string{"good"}))
+			Eventually(output).Should(gbytes.Say(`Hostname #1: The answer can not contain control characters!\nHostname #1: `))
+		})
+	})
+
+	Context("with list checks", func() {
+		var actor interact.Actor
+
+		JustBeforeEach(func() {
+			actor = interact.NewActor(gbytes.BufferWithBytes([]byte(userInput)), output).
+				WithListChecks(interact.MinItems(2), interact.MaxItems(3), interact.UniqueItems)
+		})
+
+		Context("with too few items and the user continuing", func() {
+			BeforeEach(func() {
+				userInput = "a\n\ny\nb\n\n"
+			})
+
+			It("should let the user add more items", func() {
+				items, err := actor.PromptList(message)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(items).To(Equal([]string{"a", "b"}))
+				Eventually(output).Should(gbytes.Say(`Please enter at least 2 item\(s\)!\nDo you want to try again\? \[y/N\]: `))
+			})
+		})
+
+		Context("with too many items and the user giving up", func() {
+			BeforeEach(func() {
+				userInput = "a\nb\nc\nd\n\nn\n"
+			})
+
+			It("should return an error", func() {
+				_, err := actor.PromptList(message)
+				Expect(err).To(MatchError("Command aborted"))
+				Eventually(output).Should(gbytes.Say(`Please enter at most 3 item\(s\)!`))
+			})
+		})
+
+		Context("with duplicate items", func() {
+			BeforeEach(func() {
+				userInput = "a\na\n\ny\n-\nb\n\n"
+			})
+
+			It("should let the user fix the list", func() {
+				items, err := actor.PromptList(message)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(items).To(Equal([]string{"a", "b"}))
+				Eventually(output).Should(gbytes.Say(`a was entered more than once!`))
+			})
+		})
+
+		It("should strip escape sequences from the duplicate item", func() {
+			err := interact.UniqueItems([]string{"\x1b[2Ja", "\x1b[2Ja"})
+			Expect(err).To(MatchError("a was entered more than once!"))
+		})
+	})
+})
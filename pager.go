@@ -0,0 +1,99 @@
+package interact
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// Page shows the text to the user one screen at a time, so that long output
+// such as a plan or a diff can be reviewed before a following prompt. On a
+// terminal, text that doesn't fit on the screen is piped through $PAGER or,
+// if that isn't set, shown with a built-in pager where space shows the next
+// page, enter the next line and q quits. Otherwise the text is written as is.
+func (a Actor) Page(text string) error {
+	a, unlock := a.lock()
+	defer unlock()
+	a.status.clear()
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	if !a.interactive() {
+		_, err := fmt.Fprint(a.w, text)
+		return err
+	}
+	fd, _ := terminalFd(a.w)
+	width, height, err := term.GetSize(fd)
+	if err != nil {
+		return err
+	}
+	lines := wrapLines(strings.Split(strings.TrimSuffix(text, "\n"), "\n"), width)
+	if height < 2 || len(lines) < height {
+		_, err := fmt.Fprint(a.w, text)
+		return err
+	}
+	if pager := os.Getenv("PAGER"); pager != "" {
+		cmd := exec.Command("sh", "-c", pager)
+		cmd.Stdin = strings.NewReader(text)
+		cmd.Stdout = a.w
+		cmd.Stderr = os.Stderr
+		return cmd.Run()
+	}
+	return a.withRawMode(func() error {
+		return a.pageKeys(lines, height-1)
+	})
+}
+
+// pageKeys shows the lines a page of the given height at a time, waiting for
+// the user to press a key after every page
+func (a Actor) pageKeys(lines []string, height int) error {
+	shown := 0
+	more := height
+	for {
+		for ; more > 0 && shown < len(lines); more-- {
+			fmt.Fprint(a.w, lines[shown], "\r\n")
+			shown++
+		}
+		if shown == len(lines) {
+			return nil
+		}
+		fmt.Fprintf(a.w, "\033[7m--More-- (%d%%)\033[0m", shown*100/len(lines))
+		k, err := readKey(a.rd)
+		fmt.Fprint(a.w, "\r\033[K")
+		if err != nil {
+			return err
+		}
+		switch k {
+		case ' ':
+			more = height
+		case keyEnter, keyDown:
+			more = 1
+		case 'q', keyEscape:
+			return nil
+		case keyInterrupt:
+			return ErrInterrupted
+		}
+	}
+}
+
+// wrapLines splits the lines that are wider than the terminal into as many
+// lines as they take up on the screen
+func wrapLines(lines []string, width int) []string {
+	if width <= 0 {
+		return lines
+	}
+	var wrapped []string
+	for _, line := range lines {
+		for utf8.RuneCountInString(line) > width {
+			runes := []rune(line)
+			wrapped = append(wrapped, string(runes[:width]))
+			line = string(runes[width:])
+		}
+		wrapped = append(wrapped, line)
+	}
+	return wrapped
+}
@@ -0,0 +1,68 @@
+package interact_test
+
+import (
+	"strings"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Page", func() {
+	It("should write the text as is when not on a terminal", func() {
+		Expect(actor.Page("line 1\nline 2\n")).To(Succeed())
+		Expect(string(output.Contents())).To(Equal("line 1\nline 2\n"))
+	})
+
+	It("should end the text with a newline", func() {
+		Expect(actor.Page("line 1")).To(Succeed())
+		Expect(string(output.Contents())).To(Equal("line 1\n"))
+	})
+
+	Describe("wrapping lines", func() {
+		It("should split the lines that are wider than the terminal", func() {
+			Expect(interact.WrapLines([]string{"abcdefg", "éééé", "", "abc"}, 3)).
+				To(Equal([]string{"abc", "def", "g", "ééé", "é", "", "abc"}))
+		})
+
+		It("should keep the lines as they are without a width", func() {
+			Expect(interact.WrapLines([]string{"abcdefg"}, 0)).To(Equal([]string{"abcdefg"}))
+		})
+	})
+
+	Describe("paging", func() {
+		var (
+			lines = []string{"line 1", "line 2", "line 3", "line 4", "line 5"}
+			page  = func(keys string) error {
+				return interact.NewActor(strings.NewReader(keys), output).PageKeys(lines, 2)
+			}
+		)
+
+		It("should show the next page on space", func() {
+			Expect(page("  ")).To(Succeed())
+			Eventually(output).Should(gbytes.Say(`^line 1\r\nline 2\r\n\x1b\[7m--More-- \(40%\)\x1b\[0m\r\x1b\[K`))
+			Eventually(output).Should(gbytes.Say(`^line 3\r\nline 4\r\n\x1b\[7m--More-- \(80%\)\x1b\[0m`))
+		})
+
+		It("should show the next line on enter", func() {
+			Expect(page("\r ")).To(Succeed())
+			Eventually(output).Should(gbytes.Say(`\(40%\)\x1b\[0m\r\x1b\[Kline 3\r\n\x1b\[7m--More-- \(60%\)`))
+			Eventually(output).Should(gbytes.Say(`line 4\r\nline 5\r\n$`))
+		})
+
+		It("should stop on q", func() {
+			Expect(page("q")).To(Succeed())
+			Expect(string(output.Contents())).NotTo(ContainSubstring("line 3"))
+		})
+
+		It("should return ErrInterrupted on Ctrl-C", func() {
+			Expect(page("\x03")).To(Equal(interact.ErrInterrupted))
+			Expect(string(output.Contents())).NotTo(ContainSubstring("line 3"))
+		})
+
+		It("should return ErrEndOfInput if the input ends", func() {
+			Expect(page("")).To(Equal(interact.ErrEndOfInput))
+		})
+	})
+})
@@ -0,0 +1,157 @@
+package interact
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var errEmptyPath = errors.New("Please enter a path!")
+
+// PathType specifies what kind of file system entry a path has to refer to
+type PathType int
+
+// Possible kinds of file system entries a path can refer to
+const (
+	AnyPath PathType = iota
+	FilePath
+	DirPath
+)
+
+// PathOptions configure the checks and conversions PromptPath applies to the
+// user's input. The zero value accepts any non-empty path as is.
+type PathOptions struct {
+	// MustExist requires the path to exist
+	MustExist bool
+	// MustNotExist requires the path to not exist yet
+	MustNotExist bool
+	// Type restricts what the path may refer to, if it exists
+	Type PathType
+	// Extensions, if not empty, restricts the path to files with one of the
+	// given extensions, e.g. ".yaml". The comparison ignores case.
+	Extensions []string
+	// Expand replaces a leading "~" with the user's home directory and
+	// environment variables like $HOME or ${HOME} with their values
+	Expand bool
+	// Absolute converts the path to an absolute path
+	Absolute bool
+}
+
+// PromptPath asks the user for a path to a file or a directory, applies the
+// conversions and checks configured in the options, followed by the list of
+// added checks, and returns the converted path. On a terminal, pressing tab
+// completes the path from the file system. If any of the checks fail, the user
+// is asked whether they want to try again, just like with PromptAndRetry.
+func (a Actor) PromptPath(message string, opts PathOptions, checks ...InputCheck) (string, error) {
+	a, unlock := a.lock()
+	defer unlock()
+	message = a.sanitize(message)
+	return a.retry(func(a Actor) (string, error) {
+		input, err := a.readPath(message)
+		if err != nil {
+			return "", err
+		} else if input == "" {
+			return "", errEmptyPath
+		}
+		path, err := opts.convert(input)
+		if err != nil {
+			return "", err
+		}
+		return a.checkInput(path, append([]InputCheck{opts.check}, checks...)...)
+	})
+}
+
+func (a Actor) readPath(message string) (string, error) {
+	var (
+		input string
+		err   error
+	)
+	if a.ui != nil || !a.interactive() {
+		input, err = a.ask(question{Kind: kindInput, Message: message})
+	} else {
+		a.status.clear()
+		input, err = a.readLineKeys(message+": ", completePath)
+	}
+	if err != nil {
+		return "", err
+	}
+	return a.transform(input), nil
+}
+
+func (opts PathOptions) convert(path string) (string, error) {
+	if opts.Expand {
+		path = expandPath(path)
+	}
+	if opts.Absolute {
+		return filepath.Abs(path)
+	}
+	return path, nil
+}
+
+func (opts PathOptions) check(path string) error {
+	info, err := os.Stat(path)
+	switch {
+	case err != nil && !os.IsNotExist(err):
+		return err
+	case err != nil && opts.MustExist:
+		return fmt.Errorf("%s does not exist!", Sanitize(path))
+	case err == nil && opts.MustNotExist:
+		return fmt.Errorf("%s already exists!", Sanitize(path))
+	case err == nil && opts.Type == FilePath && info.IsDir():
+		return fmt.Errorf("%s is a directory!", Sanitize(path))
+	case err == nil && opts.Type == DirPath && !info.IsDir():
+		return fmt.Errorf("%s is not a directory!", Sanitize(path))
+	}
+	if len(opts.Extensions) == 0 {
+		return nil
+	}
+	ext := filepath.Ext(path)
+	for _, allowed := range opts.Extensions {
+		if strings.EqualFold(ext, allowed) {
+			return nil
+		}
+	}
+	return fmt.Errorf("The file should have one of the extensions: %s!", strings.Join(opts.Extensions, ", "))
+}
+
+// expandPath replaces a leading "~" with the user's home directory and
+// environment variables with their values
+func expandPath(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") || strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = home + path[1:]
+		}
+	}
+	return os.ExpandEnv(path)
+}
+
+// completePath returns the entries of the file system that start with the
+// given path. Directories end with a separator, so that completion can
+// continue into them. Hidden entries are only included if the last element
+// of the path starts with a dot.
+func completePath(path string) []string {
+	dir, base := filepath.Split(path)
+	lookup := expandPath(dir)
+	if lookup == "" {
+		lookup = "."
+	}
+	entries, err := os.ReadDir(lookup)
+	if err != nil {
+		return nil
+	}
+	var candidates []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, base) || strings.HasPrefix(name, ".") && !strings.HasPrefix(base, ".") {
+			continue
+		}
+		candidate := dir + name
+		if info, err := os.Stat(filepath.Join(lookup, name)); err == nil && info.IsDir() {
+			candidate += string(filepath.Separator)
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates
+}
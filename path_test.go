@@ -0,0 +1,185 @@
+package interact_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("PromptPath", func() {
+	var (
+		message = "Config file"
+		dir     string
+		opts    interact.PathOptions
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "interact")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(dir, "config.yaml"), nil, 0644)).To(Succeed())
+		Expect(os.Mkdir(filepath.Join(dir, "sub"), 0755)).To(Succeed())
+		opts = interact.PathOptions{}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Context("without any options", func() {
+		BeforeEach(func() {
+			userInput = "some/path\n"
+		})
+
+		It("should return the path as is", func() {
+			path, err := actor.PromptPath(message, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal("some/path"))
+			Eventually(output).Should(gbytes.Say(`Config file: `))
+		})
+	})
+
+	Context("with an empty path and then a path", func() {
+		BeforeEach(func() {
+			userInput = "\ny\nsome/path\n"
+		})
+
+		It("should ask to retry", func() {
+			path, err := actor.PromptPath(message, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal("some/path"))
+			Eventually(output).Should(gbytes.Say(`Please enter a path!\nDo you want to try again\? \[y/N\]: `))
+		})
+	})
+
+	Context("with the path required to exist", func() {
+		BeforeEach(func() {
+			opts.MustExist = true
+		})
+
+		It("should accept an existing path", func() {
+			actor = interact.NewActor(gbytes.BufferWithBytes([]byte(filepath.Join(dir, "config.yaml")+"\n")), output)
+			_, err := actor.PromptPath(message, opts)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject a missing path", func() {
+			actor = interact.NewActor(gbytes.BufferWithBytes([]byte(filepath.Join(dir, "missing.yaml")+"\nn\n")), output)
+			_, err := actor.PromptPath(message, opts)
+			Expect(err).To(HaveOccurred())
+			Eventually(output).Should(gbytes.Say(`missing\.yaml does not exist!`))
+		})
+	})
+
+	Context("with the path required to not exist", func() {
+		BeforeEach(func() {
+			opts.MustNotExist = true
+		})
+
+		It("should reject an existing path", func() {
+			actor = interact.NewActor(gbytes.BufferWithBytes([]byte(filepath.Join(dir, "config.yaml")+"\nn\n")), output)
+			_, err := actor.PromptPath(message, opts)
+			Expect(err).To(HaveOccurred())
+			Eventually(output).Should(gbytes.Say(`config\.yaml already exists!`))
+		})
+	})
+
+	Context("with the path required to be a file", func() {
+		BeforeEach(func() {
+			opts.Type = interact.FilePath
+		})
+
+		It("should reject a directory", func() {
+			actor = interact.NewActor(gbytes.BufferWithBytes([]byte(filepath.Join(dir, "sub")+"\nn\n")), output)
+			_, err := actor.PromptPath(message, opts)
+			Expect(err).To(HaveOccurred())
+			Eventually(output).Should(gbytes.Say(`sub is a directory!`))
+		})
+	})
+
+	Context("with the path required to be a directory", func() {
+		BeforeEach(func() {
+			opts.Type = interact.DirPath
+		})
+
+		It("should reject a file", func() {
+			actor = interact.NewActor(gbytes.BufferWithBytes([]byte(filepath.Join(dir, "config.yaml")+"\nn\n")), output)
+			_, err := actor.PromptPath(message, opts)
+			Expect(err).To(HaveOccurred())
+			Eventually(output).Should(gbytes.Say(`config\.yaml is not a directory!`))
+		})
+	})
+
+	Context("with allowed extensions", func() {
+		BeforeEach(func() {
+			opts.Extensions = []string{".yaml", ".yml"}
+		})
+
+		It("should accept an allowed extension regardless of case", func() {
+			actor = interact.NewActor(gbytes.BufferWithBytes([]byte("config.YML\n")), output)
+			_, err := actor.PromptPath(message, opts)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject other extensions", func() {
+			actor = interact.NewActor(gbytes.BufferWithBytes([]byte("config.json\nn\n")), output)
+			_, err := actor.PromptPath(message, opts)
+			Expect(err).To(HaveOccurred())
+			Eventually(output).Should(gbytes.Say(`The file should have one of the extensions: \.yaml, \.yml!`))
+		})
+	})
+
+	Context("with expansion", func() {
+		BeforeEach(func() {
+			opts.Expand = true
+			os.Setenv("INTERACT_TEST_DIR", "configs")
+			userInput = "~/$INTERACT_TEST_DIR/app.yaml\n"
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("INTERACT_TEST_DIR")
+		})
+
+		It("should expand the home directory and environment variables", func() {
+			home, err := os.UserHomeDir()
+			Expect(err).NotTo(HaveOccurred())
+			path, err := actor.PromptPath(message, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal(home + "/configs/app.yaml"))
+		})
+	})
+
+	Context("with conversion to an absolute path", func() {
+		BeforeEach(func() {
+			opts.Absolute = true
+			userInput = "some/path\n"
+		})
+
+		It("should return an absolute path", func() {
+			wd, err := os.Getwd()
+			Expect(err).NotTo(HaveOccurred())
+			path, err := actor.PromptPath(message, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal(filepath.Join(wd, "some/path")))
+		})
+	})
+
+	Context("with an additional check", func() {
+		BeforeEach(func() {
+			opts.Absolute = true
+			userInput = "some/path\n"
+		})
+
+		It("should run the check on the converted path", func() {
+			_, err := actor.PromptPath(message, opts, func(path string) error {
+				Expect(filepath.IsAbs(path)).To(BeTrue())
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
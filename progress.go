@@ -0,0 +1,251 @@
+package interact
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// spinnerFrames are drawn in turn when the writer is a terminal
+	spinnerFrames = []string{"-", "\\", "|", "/"}
+	// spinnerFrameInterval is how often the spinner is redrawn on a terminal
+	spinnerFrameInterval = 100 * time.Millisecond
+	// plainUpdateInterval is how often a line is logged about ongoing work when
+	// the writer is not a terminal
+	plainUpdateInterval = 5 * time.Second
+	// progressBarWidth is the number of characters between the brackets of a
+	// progress bar
+	progressBarWidth = 30
+)
+
+// indicator is a spinner or a progress bar drawn on an Actor's writer
+type indicator interface {
+	// halt stops the indicator from drawing and clears it from the terminal
+	halt()
+}
+
+// status tracks the indicator currently drawn on an Actor's writer, so that it
+// can be stopped before anything else is written
+type status struct {
+	mu      sync.Mutex
+	current indicator
+}
+
+func (s *status) start(ind indicator) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current != nil {
+		s.current.halt()
+	}
+	s.current = ind
+}
+
+func (s *status) stop(ind indicator) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current == ind {
+		s.current = nil
+	}
+	ind.halt()
+}
+
+// clear stops the current indicator, if any. It has to be called before
+// writing a prompt.
+func (s *status) clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current != nil {
+		s.current.halt()
+		s.current = nil
+	}
+}
+
+// A Spinner shows the user that a long-running step is in progress. On a
+// terminal it is redrawn in place, otherwise a line is logged periodically.
+type Spinner struct {
+	message string
+	w       io.Writer
+	tty     bool
+	status  *status
+	started time.Time
+	done    chan struct{}
+	exited  chan struct{}
+	once    sync.Once
+}
+
+// Spinner starts a spinner with the given message. The spinner is stopped with
+// Stop or automatically before the Actor prints the next prompt. If another
+// goroutine is interacting with the user, the spinner is started once it's done.
+func (a Actor) Spinner(message string) *Spinner {
+	a, unlock := a.lock()
+	defer unlock()
+	return a.startSpinner(message)
+}
+
+func (a Actor) startSpinner(message string) *Spinner {
+	s := &Spinner{
+		message: message,
+		w:       a.w,
+		tty:     isTerminal(a.w),
+		status:  a.status,
+		started: time.Now(),
+		done:    make(chan struct{}),
+		exited:  make(chan struct{}),
+	}
+	a.status.start(s)
+	go s.run()
+	return s
+}
+
+// Stop stops the spinner and clears it from the terminal. It is safe to call
+// Stop more than once.
+func (s *Spinner) Stop() {
+	s.status.stop(s)
+}
+
+func (s *Spinner) run() {
+	defer close(s.exited)
+	interval := plainUpdateInterval
+	if s.tty {
+		interval = spinnerFrameInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for frame := 0; ; frame++ {
+		if s.tty {
+			fmt.Fprintf(s.w, "\r%s %s", spinnerFrames[frame%len(spinnerFrames)], s.message)
+		} else if frame == 0 {
+			fmt.Fprintf(s.w, "%s...\n", s.message)
+		} else {
+			elapsed := time.Since(s.started).Round(time.Second)
+			fmt.Fprintf(s.w, "%s... (%v)\n", s.message, elapsed)
+		}
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Spinner) halt() {
+	s.once.Do(func() {
+		close(s.done)
+		<-s.exited
+		if s.tty {
+			clearLine(s.w)
+		}
+	})
+}
+
+// A Progress shows the user how much of a long-running step has been completed.
+// On a terminal a progress bar is redrawn in place, otherwise a line is logged
+// periodically.
+type Progress struct {
+	mu       sync.Mutex
+	total    int
+	current  int
+	w        io.Writer
+	tty      bool
+	status   *status
+	halted   bool
+	lastDraw time.Time
+}
+
+// Progress starts a progress bar for the given total amount of work. The
+// progress bar is stopped with Stop or automatically before the Actor prints
+// the next prompt. If another goroutine is interacting with the user, the
+// progress bar is started once it's done.
+func (a Actor) Progress(total int) *Progress {
+	a, unlock := a.lock()
+	defer unlock()
+	p := &Progress{
+		total:  total,
+		w:      a.w,
+		tty:    isTerminal(a.w),
+		status: a.status,
+	}
+	a.status.start(p)
+	p.mu.Lock()
+	p.draw()
+	p.mu.Unlock()
+	return p
+}
+
+// Add increases the amount of completed work by n
+func (p *Progress) Add(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.update(p.current + n)
+}
+
+// Set sets the amount of completed work to n
+func (p *Progress) Set(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.update(n)
+}
+
+// Stop stops the progress bar and clears it from the terminal. It is safe to
+// call Stop more than once.
+func (p *Progress) Stop() {
+	p.status.stop(p)
+}
+
+func (p *Progress) update(n int) {
+	if n > p.total {
+		n = p.total
+	}
+	if n < 0 {
+		n = 0
+	}
+	if n == p.current {
+		return
+	}
+	p.current = n
+	if p.halted {
+		return
+	}
+	interval := plainUpdateInterval
+	if p.tty {
+		interval = spinnerFrameInterval
+	}
+	if p.current == p.total || time.Since(p.lastDraw) >= interval {
+		p.draw()
+	}
+}
+
+func (p *Progress) draw() {
+	p.lastDraw = time.Now()
+	percent := 100
+	if p.total > 0 {
+		percent = p.current * 100 / p.total
+	}
+	if !p.tty {
+		fmt.Fprintf(p.w, "%d/%d (%d%%)\n", p.current, p.total, percent)
+		return
+	}
+	filled := progressBarWidth * percent / 100
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", progressBarWidth-filled)
+	fmt.Fprintf(p.w, "\r[%s] %d/%d %3d%%", bar, p.current, p.total, percent)
+}
+
+func (p *Progress) halt() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.halted {
+		return
+	}
+	p.halted = true
+	if p.tty {
+		clearLine(p.w)
+	}
+}
+
+// clearLine erases the line the cursor is on and moves the cursor to its start
+func clearLine(w io.Writer) {
+	fmt.Fprint(w, "\r\033[K")
+}
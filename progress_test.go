@@ -0,0 +1,74 @@
+package interact_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Progress", func() {
+	Describe("Spinner", func() {
+		It("should log the message when not writing to a terminal", func() {
+			spinner := actor.Spinner("Working")
+			spinner.Stop()
+			Eventually(output).Should(gbytes.Say(`Working\.\.\.\n`))
+		})
+
+		It("should be safe to stop more than once", func() {
+			spinner := actor.Spinner("Working")
+			spinner.Stop()
+			spinner.Stop()
+		})
+
+		Context("with a prompt following the spinner", func() {
+			BeforeEach(func() {
+				userInput = "user-input\n"
+			})
+
+			It("should stop the spinner before prompting", func() {
+				actor.Spinner("Working")
+				input, err := actor.Prompt("Please answer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(input).To(Equal("user-input"))
+				Eventually(output).Should(gbytes.Say(`Working\.\.\.\nPlease answer: `))
+				Consistently(output).ShouldNot(gbytes.Say(`Working`))
+			})
+		})
+	})
+
+	Describe("Progress", func() {
+		It("should log the initial progress", func() {
+			actor.Progress(10)
+			Eventually(output).Should(gbytes.Say(`0/10 \(0%\)\n`))
+		})
+
+		It("should log the completed progress", func() {
+			progress := actor.Progress(10)
+			progress.Add(4)
+			progress.Set(10)
+			progress.Stop()
+			Eventually(output).Should(gbytes.Say(`0/10 \(0%\)\n10/10 \(100%\)\n`))
+		})
+
+		It("should not go past the total", func() {
+			progress := actor.Progress(10)
+			progress.Add(20)
+			Eventually(output).Should(gbytes.Say(`10/10 \(100%\)\n`))
+		})
+
+		It("should not go below zero", func() {
+			progress := actor.Progress(10)
+			progress.Add(-5)
+			progress.Add(10)
+			progress.Stop()
+			Eventually(output).Should(gbytes.Say(`^0/10 \(0%\)\n10/10 \(100%\)\n$`))
+		})
+
+		It("should not log anything after being stopped", func() {
+			progress := actor.Progress(10)
+			progress.Stop()
+			progress.Set(10)
+			Consistently(output).ShouldNot(gbytes.Say(`10/10`))
+		})
+	})
+})
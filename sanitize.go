@@ -0,0 +1,105 @@
+package interact
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Sanitize removes terminal escape sequences (such as ANSI colors, cursor
+// movements and OSC sequences that set the window title or add hyperlinks) and
+// all control characters except newlines and tabs from s, so that text from
+// an untrusted source can't rewrite the terminal or hide other text when it's
+// printed. Invalid UTF-8 is replaced with the Unicode replacement character.
+func Sanitize(s string) string {
+	runes := []rune(strings.ToValidUTF8(s, string(unicode.ReplacementChar)))
+	var b strings.Builder
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == 0x1b:
+			i = skipEscape(runes, i+1)
+		case r == 0x9b:
+			i = skipCSI(runes, i+1)
+		case r == 0x90 || r == 0x98 || r == 0x9d || r == 0x9e || r == 0x9f:
+			i = skipControlString(runes, i+1)
+		case r == '\n' || r == '\t' || !unicode.IsControl(r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// WithSanitizedMessages returns a copy of the Actor that sanitizes (see
+// Sanitize) the messages, options and defaults of its prompts before printing
+// them, for when they're built from untrusted data, such as the names of
+// branches fetched from elsewhere
+func (a Actor) WithSanitizedMessages() Actor {
+	a.sanitizeMessages = true
+	return a
+}
+
+func (a Actor) sanitize(s string) string {
+	if !a.sanitizeMessages {
+		return s
+	}
+	return Sanitize(s)
+}
+
+func (a Actor) sanitizeAll(strs []string) []string {
+	if !a.sanitizeMessages {
+		return strs
+	}
+	sanitized := make([]string, len(strs))
+	for i, s := range strs {
+		sanitized[i] = Sanitize(s)
+	}
+	return sanitized
+}
+
+// The skip functions below are given the index of the rune following the
+// introducer of a sequence and return the index of the last rune of the
+// sequence
+
+// skipEscape skips a sequence that starts with ESC
+func skipEscape(runes []rune, i int) int {
+	if i >= len(runes) {
+		return i - 1
+	}
+	switch runes[i] {
+	case '[':
+		return skipCSI(runes, i+1)
+	case ']', 'P', 'X', '^', '_':
+		return skipControlString(runes, i+1)
+	}
+	// Intermediate bytes followed by a final byte, e.g. "\x1b(B"
+	for i < len(runes)-1 && runes[i] >= 0x20 && runes[i] <= 0x2f {
+		i++
+	}
+	return i
+}
+
+// skipCSI skips the parameters, intermediates and the final byte of a control
+// sequence, such as "31m" in "\x1b[31m"
+func skipCSI(runes []rune, i int) int {
+	for i < len(runes) && runes[i] >= 0x20 && runes[i] <= 0x3f {
+		i++
+	}
+	if i < len(runes) && runes[i] >= 0x40 && runes[i] <= 0x7e {
+		return i
+	}
+	return i - 1
+}
+
+// skipControlString skips an OSC, DCS, SOS, PM or APC string up to and
+// including the BEL or the string terminator that ends it. An unterminated
+// string is skipped to the end.
+func skipControlString(runes []rune, i int) int {
+	for ; i < len(runes); i++ {
+		switch {
+		case runes[i] == 0x07 || runes[i] == 0x9c:
+			return i
+		case runes[i] == 0x1b && i+1 < len(runes) && runes[i+1] == '\\':
+			return i + 1
+		}
+	}
+	return len(runes) - 1
+}
@@ -0,0 +1,53 @@
+package interact_test
+
+import (
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Sanitize", func() {
+	DescribeTable("removing escape sequences and control characters",
+		func(input, expected string) {
+			Expect(interact.Sanitize(input)).To(Equal(expected))
+		},
+		Entry("plain text", "feature/ünïcode", "feature/ünïcode"),
+		Entry("newlines and tabs", "a\n\tb", "a\n\tb"),
+		Entry("colors", "\x1b[1;31mred\x1b[0m", "red"),
+		Entry("cursor movements", "main\x1b[2A\x1b[2Kdeleted", "maindeleted"),
+		Entry("a window title ending with BEL", "a\x1b]0;pwned\x07b", "ab"),
+		Entry("a hyperlink ending with ST", "\x1b]8;;http://evil\x1b\\link\x1b]8;;\x1b\\", "link"),
+		Entry("an unterminated OSC", "a\x1b]0;pwned", "a"),
+		Entry("a DCS string", "a\x1bPq#0\x1b\\b", "ab"),
+		Entry("a charset designation", "a\x1b(Bb", "ab"),
+		Entry("a lone escape at the end", "a\x1b", "a"),
+		Entry("an 8-bit CSI", "a\u009b31mb", "ab"),
+		Entry("carriage returns and backspaces", "safe\rrm -rf\b\b", "saferm -rf"),
+		Entry("NUL and DEL", "a\x00b\x7fc", "abc"),
+		Entry("invalid UTF-8", "a\xffb", "a�b"),
+	)
+})
+
+var _ = Describe("WithSanitizedMessages", func() {
+	var branch = "main\x1b[2K\x1b[1Afeature"
+
+	BeforeEach(func() {
+		userInput = "y\n1\n"
+	})
+
+	It("should sanitize the messages and options", func() {
+		sanitized := actor.WithSanitizedMessages()
+		Expect(sanitized.Confirm("Delete "+branch+"?", interact.ConfirmDefaultToNo)).To(BeTrue())
+		Expect(sanitized.Select("Branch", []string{branch})).To(Equal(0))
+		Eventually(output).Should(gbytes.Say(`Delete mainfeature\? \[y/N\]: `))
+		Eventually(output).Should(gbytes.Say(`1\) mainfeature\n`))
+		Expect(output.Contents()).NotTo(ContainSubstring("\x1b"))
+	})
+
+	It("should leave the messages as is by default", func() {
+		Expect(actor.Confirm("Delete "+branch+"?", interact.ConfirmDefaultToNo)).To(BeTrue())
+		Expect(output.Contents()).To(ContainSubstring(branch))
+	})
+})
@@ -0,0 +1,68 @@
+package interact
+
+import (
+	"fmt"
+)
+
+// PromptSecret works exactly like Prompt, but doesn't echo what the user types
+// if the Actor reads from a terminal, e.g. for asking a password. Echo is
+// turned off even if the output is redirected, e.g. to a log file.
+func (a Actor) PromptSecret(message string, checks ...InputCheck) (string, error) {
+	a, unlock := a.lock()
+	defer unlock()
+	input, err := a.ask(question{Kind: kindSecret, Message: a.sanitize(message)})
+	if err != nil {
+		return "", err
+	}
+	return a.checkInput(a.transform(input), checks...)
+}
+
+// promptSecret reads a line without echoing it if the Actor reads from a
+// terminal, no matter where its output goes. Like promptLine, it doesn't
+// transform the line.
+func (a Actor) promptSecret(message string) (string, error) {
+	if !isTerminal(a.in) {
+		return a.promptLine(message)
+	}
+	newline := "\n"
+	if isTerminal(a.w) {
+		// Output isn't post-processed in raw mode
+		newline = "\r\n"
+	}
+	a.status.clear()
+	fmt.Fprint(a.w, message)
+	var line []rune
+	err := a.withRawMode(func() error {
+		for {
+			k, err := readKey(a.rd)
+			if err != nil {
+				return err
+			}
+			switch k {
+			case keyEnter:
+				fmt.Fprint(a.w, newline)
+				return nil
+			case keyBackspace:
+				if len(line) > 0 {
+					line = line[:len(line)-1]
+				}
+			case keyInterrupt:
+				fmt.Fprint(a.w, newline)
+				return ErrInterrupted
+			case keyEndOfInput:
+				if len(line) == 0 {
+					fmt.Fprint(a.w, newline)
+					return ErrEndOfInput
+				}
+			default:
+				if k >= 0 && a.fits(string(line), rune(k)) {
+					line = append(line, rune(k))
+				}
+			}
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(line), nil
+}
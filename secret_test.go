@@ -0,0 +1,20 @@
+package interact_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("PromptSecret", func() {
+	BeforeEach(func() {
+		userInput = " hunter2 \n"
+	})
+
+	It("should read a line when not on a terminal", func() {
+		input, err := actor.PromptSecret("Password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(input).To(Equal("hunter2"))
+		Eventually(output).Should(gbytes.Say(`Password: `))
+	})
+})
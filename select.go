@@ -0,0 +1,262 @@
+package interact
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var errNoOptions = errors.New("There are no options to select from!")
+
+// Select asks the user to select one of the options and returns the index of
+// the selected option. On a terminal the arrow keys move a cursor over the
+// options and enter selects the option under it. Otherwise the options are
+// numbered and the user enters the number (or the name) of an option. An error
+// is returned if there are no options.
+func (a Actor) Select(message string, options []string) (int, error) {
+	if len(options) == 0 {
+		return -1, errNoOptions
+	}
+	a, unlock := a.lock()
+	defer unlock()
+	a.status.clear()
+	message, options = a.sanitize(message), a.sanitizeAll(options)
+	if a.ui != nil {
+		selected, err := a.askSelect(kindSelect, message, options)
+		if err != nil {
+			return -1, err
+		}
+		return selected[0], nil
+	} else if a.interactive() {
+		selected, _, err := a.selectKeys(message, options, false)
+		if err != nil {
+			return -1, err
+		}
+		screen{a.w}.finish(fmt.Sprintf("%s: %s", message, options[selected]))
+		return selected, nil
+	}
+	for {
+		printNumbered(a.w, message, options)
+		input, err := a.prompt("Enter a number: ")
+		if isInvalidInput(err) {
+			fmt.Fprintln(a.w, err)
+			continue
+		} else if err != nil {
+			return -1, err
+		}
+		selected, err := a.parseOption(input, options)
+		if err != nil {
+			fmt.Fprintln(a.w, err)
+			continue
+		}
+		return selected, nil
+	}
+}
+
+// MultiSelect asks the user to select any number of the options and returns
+// the indexes of the selected options in ascending order, each only once. On a terminal the arrow keys
+// move a cursor over the options, space toggles the option under it and enter
+// confirms the selection. Otherwise the options are numbered and the user
+// enters the numbers separated by commas. An error is returned if there are no
+// options.
+func (a Actor) MultiSelect(message string, options []string) ([]int, error) {
+	if len(options) == 0 {
+		return nil, errNoOptions
+	}
+	a, unlock := a.lock()
+	defer unlock()
+	a.status.clear()
+	message, options = a.sanitize(message), a.sanitizeAll(options)
+	if a.ui != nil {
+		selected, err := a.askSelect(kindMultiSelect, message, options)
+		if err != nil {
+			return nil, err
+		}
+		checked := make([]bool, len(options))
+		for _, i := range selected {
+			checked[i] = true
+		}
+		return checkedIndexes(checked), nil
+	} else if a.interactive() {
+		_, checked, err := a.selectKeys(message, options, true)
+		if err != nil {
+			return nil, err
+		}
+		selected := checkedIndexes(checked)
+		names := make([]string, len(selected))
+		for j, i := range selected {
+			names[j] = options[i]
+		}
+		screen{a.w}.finish(fmt.Sprintf("%s: %s", message, strings.Join(names, ", ")))
+		return selected, nil
+	}
+outer:
+	for {
+		printNumbered(a.w, message, options)
+		input, err := a.prompt("Enter numbers separated by commas: ")
+		if isInvalidInput(err) {
+			fmt.Fprintln(a.w, err)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		checked := make([]bool, len(options))
+		for _, field := range strings.Split(input, ",") {
+			if field = strings.TrimSpace(field); field == "" {
+				continue
+			}
+			i, err := a.parseOption(field, options)
+			if err != nil {
+				fmt.Fprintln(a.w, err)
+				continue outer
+			}
+			checked[i] = true
+		}
+		return checkedIndexes(checked), nil
+	}
+}
+
+// checkedIndexes returns the indexes of the checked options in ascending order
+func checkedIndexes(checked []bool) []int {
+	selected := []int{}
+	for i, c := range checked {
+		if c {
+			selected = append(selected, i)
+		}
+	}
+	return selected
+}
+
+// askSelect asks a select or a multiselect question through the Actor's
+// frontend and returns the indexes of the selected options
+func (a Actor) askSelect(kind questionKind, message string, options []string) ([]int, error) {
+	a.status.clear()
+	answer, err := a.ui.ask(question{ID: a.id, Kind: kind, Message: message, Options: options, Help: a.help})
+	if err != nil {
+		return nil, err
+	}
+	selected := []int{}
+	for _, field := range strings.Split(answer, ",") {
+		if field == "" {
+			continue
+		}
+		i, err := strconv.Atoi(field)
+		if err != nil || i < 0 || i >= len(options) || kind == kindSelect && len(selected) > 0 {
+			return nil, fmt.Errorf("Invalid selection %q", answer)
+		}
+		selected = append(selected, i)
+	}
+	if kind == kindSelect && len(selected) == 0 {
+		return nil, fmt.Errorf("Invalid selection %q", answer)
+	}
+	return selected, nil
+}
+
+func printNumbered(w io.Writer, message string, options []string) {
+	fmt.Fprintf(w, "%s:\n", message)
+	for i, option := range options {
+		fmt.Fprintf(w, "%d) %s\n", i+1, option)
+	}
+}
+
+// parseOption returns the index of the option the user entered either by its
+// number or by its name. If the name is misspelled, the user is asked whether
+// they meant the closest option.
+func (a Actor) parseOption(input string, options []string) (int, error) {
+	if n, err := strconv.Atoi(input); err == nil {
+		if n < 1 || n > len(options) {
+			return -1, fmt.Errorf("Please enter a number between 1 and %d!", len(options))
+		}
+		return n - 1, nil
+	}
+	input, err := a.checkInput(input, OneOf(options...))
+	for i, option := range options {
+		if err == nil && option == input {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("Please enter a number between 1 and %d!", len(options))
+}
+
+// selectKeys lets the user move a cursor over the options with the arrow keys
+// until they press enter. If multi is set, space toggles the option under the
+// cursor and checkboxes are drawn in front of the options.
+func (a Actor) selectKeys(message string, options []string, multi bool) (cursor int, checked []bool, err error) {
+	checked = make([]bool, len(options))
+	s := screen{a.w}
+	err = a.withRawMode(func() error {
+		for {
+			items := options
+			if multi {
+				items = make([]string, len(options))
+				for i, option := range options {
+					box := "[ ] "
+					if checked[i] {
+						box = "[x] "
+					}
+					items[i] = box + option
+				}
+			}
+			s.draw(listLines(message+":", items, cursor)...)
+
+			k, err := readKey(a.rd)
+			if err != nil {
+				return err
+			}
+			switch k {
+			case keyUp:
+				if cursor > 0 {
+					cursor--
+				}
+			case keyDown:
+				if cursor < len(options)-1 {
+					cursor++
+				}
+			case ' ':
+				if multi {
+					checked[cursor] = !checked[cursor]
+				}
+			case keyEnter:
+				return nil
+			case keyInterrupt:
+				s.finish(message + ":")
+				return ErrInterrupted
+			case keyEndOfInput:
+				s.finish(message + ":")
+				return ErrEndOfInput
+			}
+		}
+	})
+	return cursor, checked, err
+}
+
+// listLines returns the lines for drawing a list of items below the header,
+// with a marker in front of the item at the cursor. The list is scrolled so
+// that the cursor is always visible.
+func listLines(header string, items []string, cursor int) []string {
+	lines := []string{header}
+	if len(items) == 0 {
+		return append(lines, "  No matches")
+	}
+	start := 0
+	if cursor >= maxListedOptions {
+		start = cursor - maxListedOptions + 1
+	}
+	end := start + maxListedOptions
+	if end > len(items) {
+		end = len(items)
+	}
+	for i := start; i < end; i++ {
+		marker := "  "
+		if i == cursor {
+			marker = "> "
+		}
+		lines = append(lines, marker+items[i])
+	}
+	if hidden := len(items) - (end - start); hidden > 0 {
+		lines = append(lines, fmt.Sprintf("  (%d more)", hidden))
+	}
+	return lines
+}
@@ -0,0 +1,159 @@
+package interact_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Select", func() {
+	var (
+		message = "Environment"
+		options = []string{"dev", "staging", "prod"}
+	)
+
+	Describe("Select", func() {
+		Context("with the user entering a number", func() {
+			BeforeEach(func() {
+				userInput = "2\n"
+			})
+
+			It("should return the index of the option", func() {
+				i, err := actor.Select(message, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(i).To(Equal(1))
+			})
+
+			It("should list the numbered options", func() {
+				actor.Select(message, options)
+				Eventually(output).Should(gbytes.Say(`Environment:\n1\) dev\n2\) staging\n3\) prod\nEnter a number: `))
+			})
+		})
+
+		Context("with the user entering a number out of range and then a valid one", func() {
+			BeforeEach(func() {
+				userInput = "4\n3\n"
+			})
+
+			It("should ask again", func() {
+				i, err := actor.Select(message, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(i).To(Equal(2))
+				Eventually(output).Should(gbytes.Say(`Please enter a number between 1 and 3!\nEnvironment:\n`))
+			})
+		})
+
+		Context("with the user entering the name of an option", func() {
+			BeforeEach(func() {
+				userInput = "prod\n"
+			})
+
+			It("should return the index of the option", func() {
+				i, err := actor.Select(message, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(i).To(Equal(2))
+			})
+		})
+
+		Context("with the user misspelling the name of an option", func() {
+			BeforeEach(func() {
+				userInput = "stagin\ny\n"
+			})
+
+			It("should suggest the closest option", func() {
+				i, err := actor.Select(message, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(i).To(Equal(1))
+				Eventually(output).Should(gbytes.Say(`Did you mean 'staging'\? \[Y/n\]: `))
+			})
+		})
+
+		Context("with no options", func() {
+			BeforeEach(func() {
+				userInput = "1\n"
+			})
+
+			It("should return an error without asking", func() {
+				_, err := actor.Select(message, nil)
+				Expect(err).To(MatchError("There are no options to select from!"))
+				Expect(output.Contents()).To(BeEmpty())
+			})
+
+			It("should return an error with a frontend", func() {
+				_, err := actor.WithJSON().Select(message, []string{})
+				Expect(err).To(MatchError("There are no options to select from!"))
+				Expect(output.Contents()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("MultiSelect", func() {
+		Context("with the user entering numbers", func() {
+			BeforeEach(func() {
+				userInput = "3, 1\n"
+			})
+
+			It("should return the indexes of the options in ascending order", func() {
+				selected, err := actor.MultiSelect(message, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(selected).To(Equal([]int{0, 2}))
+				Eventually(output).Should(gbytes.Say(`Enter numbers separated by commas: `))
+			})
+		})
+
+		Context("with the user entering a number more than once", func() {
+			BeforeEach(func() {
+				userInput = "1,1,2\n"
+			})
+
+			It("should return each index only once", func() {
+				selected, err := actor.MultiSelect(message, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(selected).To(Equal([]int{0, 1}))
+			})
+		})
+
+		Context("with the user entering nothing", func() {
+			BeforeEach(func() {
+				userInput = "\n"
+			})
+
+			It("should return no indexes", func() {
+				selected, err := actor.MultiSelect(message, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(selected).To(BeEmpty())
+			})
+		})
+
+		Context("with the user entering an invalid number and then valid ones", func() {
+			BeforeEach(func() {
+				userInput = "1,x\n1,2\n"
+			})
+
+			It("should ask again", func() {
+				selected, err := actor.MultiSelect(message, options)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(selected).To(Equal([]int{0, 1}))
+				Eventually(output).Should(gbytes.Say(`Please enter a number between 1 and 3!\nEnvironment:\n`))
+			})
+		})
+
+		Context("with no options", func() {
+			BeforeEach(func() {
+				userInput = "1\n"
+			})
+
+			It("should return an error without asking", func() {
+				_, err := actor.MultiSelect(message, nil)
+				Expect(err).To(MatchError("There are no options to select from!"))
+				Expect(output.Contents()).To(BeEmpty())
+			})
+
+			It("should return an error with a frontend", func() {
+				_, err := actor.WithJSON().MultiSelect(message, []string{})
+				Expect(err).To(MatchError("There are no options to select from!"))
+				Expect(output.Contents()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,183 @@
+package interact
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrServerClosed is returned by the Serve and ListenAndServe methods of
+	// a Server after a call to Close
+	ErrServerClosed = errors.New("Server closed")
+	// errTooManySessions is written to connections over a Server's limit
+	errTooManySessions = errors.New("Too many sessions, please try again later")
+	errNoHandler       = errors.New("The server has no Handler")
+)
+
+// A Server serves interactive sessions over network connections, so that the
+// user can answer prompts with a tool like nc or socat, for example when a
+// long running daemon needs an operator's decision. Every connection gets its
+// own Actor that reads from and writes to the connection.
+type Server struct {
+	// Handler is called in its own goroutine for every connection with an
+	// Actor bound to the connection. The context is canceled when the
+	// connection fails or times out, or when the server is closed. If Handler
+	// returns an error, it's written to the connection. The connection is
+	// closed once Handler returns.
+	Handler func(ctx context.Context, a Actor) error
+	// IdleTimeout is how long the server waits for the user to send anything
+	// when the Actor is reading from the connection. Zero means no timeout.
+	IdleTimeout time.Duration
+	// MaxSessions is the number of connections that can be served at once.
+	// Any connections over the limit are told to try again later and closed.
+	// Zero means no limit.
+	MaxSessions int
+
+	mu        sync.Mutex
+	closed    bool
+	listeners map[net.Listener]bool
+	sessions  map[net.Conn]context.CancelFunc
+}
+
+// ListenAndServe listens on the network address (e.g. "unix" and a path to a
+// socket, or "tcp" and "localhost:7000") and serves the connections it
+// accepts. It always returns a non-nil error.
+func (s *Server) ListenAndServe(network, address string) error {
+	if s.Handler == nil {
+		return errNoHandler
+	}
+	l, err := net.Listen(network, address)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+// Serve accepts connections on the listener and serves each of them in a new
+// goroutine. It always returns a non-nil error and closes the listener.
+func (s *Server) Serve(l net.Listener) error {
+	defer l.Close()
+	if s.Handler == nil {
+		return errNoHandler
+	} else if !s.track(l) {
+		return ErrServerClosed
+	}
+	defer s.untrack(l)
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return ErrServerClosed
+			}
+			return err
+		}
+		go s.serve(conn)
+	}
+}
+
+// Close closes all of the listeners and connections of the server and cancels
+// the contexts of all sessions. It doesn't wait for the handlers to return.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	var err error
+	for l := range s.listeners {
+		if closeErr := l.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	for conn, cancel := range s.sessions {
+		cancel()
+		conn.Close()
+	}
+	return err
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer conn.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := s.startSession(conn, cancel); err != nil {
+		fmt.Fprintln(conn, err)
+		return
+	}
+	defer s.endSession(conn)
+
+	a := NewActor(&sessionReader{conn, s.IdleTimeout, cancel}, conn)
+	if err := s.Handler(ctx, a); err != nil {
+		fmt.Fprintln(conn, err)
+	}
+}
+
+func (s *Server) track(l net.Listener) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	if s.listeners == nil {
+		s.listeners = make(map[net.Listener]bool)
+	}
+	s.listeners[l] = true
+	return true
+}
+
+func (s *Server) untrack(l net.Listener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.listeners, l)
+}
+
+func (s *Server) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// startSession registers the connection, unless the server is closed or
+// already serving MaxSessions connections
+func (s *Server) startSession(conn net.Conn, cancel context.CancelFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return ErrServerClosed
+	} else if s.MaxSessions > 0 && len(s.sessions) >= s.MaxSessions {
+		return errTooManySessions
+	}
+	if s.sessions == nil {
+		s.sessions = make(map[net.Conn]context.CancelFunc)
+	}
+	s.sessions[conn] = cancel
+	return nil
+}
+
+func (s *Server) endSession(conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, conn)
+}
+
+// sessionReader reads from a connection, failing if the user doesn't send
+// anything within the idle timeout. Once reading fails, the session's context
+// is canceled.
+type sessionReader struct {
+	conn        net.Conn
+	idleTimeout time.Duration
+	cancel      context.CancelFunc
+}
+
+func (r *sessionReader) Read(p []byte) (int, error) {
+	if r.idleTimeout > 0 {
+		r.conn.SetReadDeadline(time.Now().Add(r.idleTimeout))
+	}
+	n, err := r.conn.Read(p)
+	if err != nil {
+		r.cancel()
+	}
+	return n, err
+}
@@ -0,0 +1,190 @@
+package interact_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		server   *interact.Server
+		listener net.Listener
+		answers  chan bool
+		errs     chan error
+		serveErr chan error
+		// wrap lets tests change the listener the server accepts on
+		wrap func(net.Listener) net.Listener
+	)
+
+	BeforeEach(func() {
+		answers = make(chan bool, 10)
+		errs = make(chan error, 10)
+		serveErr = make(chan error, 1)
+		wrap = func(l net.Listener) net.Listener { return l }
+		server = &interact.Server{
+			Handler: func(ctx context.Context, a interact.Actor) error {
+				confirmed, err := a.Confirm("Restart the database?", interact.ConfirmNoDefault)
+				if err != nil {
+					errs <- err
+					<-ctx.Done()
+					return err
+				}
+				answers <- confirmed
+				return nil
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		go func() {
+			serveErr <- server.Serve(wrap(listener))
+		}()
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Eventually(serveErr).Should(Receive(Equal(interact.ErrServerClosed)))
+	})
+
+	dial := func() (net.Conn, *bufio.Reader) {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		return conn, bufio.NewReader(conn)
+	}
+
+	readPrompt := func(rd *bufio.Reader) string {
+		prompt := make([]byte, len("Restart the database? [y/n]: "))
+		_, err := io.ReadFull(rd, prompt)
+		Expect(err).NotTo(HaveOccurred())
+		return string(prompt)
+	}
+
+	It("should prompt over the connection and read the answer", func() {
+		conn, rd := dial()
+		defer conn.Close()
+		Expect(readPrompt(rd)).To(Equal("Restart the database? [y/n]: "))
+		conn.Write([]byte("y\n"))
+		Eventually(answers).Should(Receive(BeTrue()))
+	})
+
+	It("should give each connection its own session", func() {
+		first, firstRd := dial()
+		defer first.Close()
+		second, secondRd := dial()
+		defer second.Close()
+		readPrompt(firstRd)
+		readPrompt(secondRd)
+		second.Write([]byte("n\n"))
+		Eventually(answers).Should(Receive(BeFalse()))
+		first.Write([]byte("y\n"))
+		Eventually(answers).Should(Receive(BeTrue()))
+	})
+
+	It("should end the session when the connection is closed", func() {
+		conn, rd := dial()
+		readPrompt(rd)
+		conn.Close()
+		Eventually(errs).Should(Receive(Equal(interact.ErrEndOfInput)))
+	})
+
+	It("should not serve without a handler", func() {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		noHandler := &interact.Server{}
+		Expect(noHandler.Serve(l)).To(MatchError("The server has no Handler"))
+		_, err = l.Accept()
+		Expect(err).To(HaveOccurred())
+		Expect(noHandler.ListenAndServe("tcp", "127.0.0.1:0")).To(MatchError("The server has no Handler"))
+	})
+
+	Context("with the server closing while accepting a connection", func() {
+		BeforeEach(func() {
+			wrap = func(l net.Listener) net.Listener {
+				return closingListener{l, server}
+			}
+		})
+
+		It("should tell the connection that the server is closed", func() {
+			conn, rd := dial()
+			defer conn.Close()
+			line, err := rd.ReadString('\n')
+			Expect(err).NotTo(HaveOccurred())
+			Expect(line).To(Equal("Server closed\n"))
+		})
+	})
+
+	Context("with a handler returning an error", func() {
+		BeforeEach(func() {
+			server.Handler = func(ctx context.Context, a interact.Actor) error {
+				return errors.New("Nothing to do")
+			}
+		})
+
+		It("should write the error to the connection", func() {
+			conn, rd := dial()
+			defer conn.Close()
+			line, err := rd.ReadString('\n')
+			Expect(err).NotTo(HaveOccurred())
+			Expect(line).To(Equal("Nothing to do\n"))
+		})
+	})
+
+	Context("with an idle timeout", func() {
+		BeforeEach(func() {
+			server.IdleTimeout = 50 * time.Millisecond
+		})
+
+		It("should end the session if the user doesn't answer in time", func() {
+			conn, rd := dial()
+			defer conn.Close()
+			readPrompt(rd)
+			var err error
+			Eventually(errs).Should(Receive(&err))
+			var netErr net.Error
+			Expect(errors.As(err, &netErr)).To(BeTrue())
+			Expect(netErr.Timeout()).To(BeTrue())
+		})
+	})
+
+	Context("with a session limit", func() {
+		BeforeEach(func() {
+			server.MaxSessions = 1
+		})
+
+		It("should turn away connections over the limit", func() {
+			first, firstRd := dial()
+			defer first.Close()
+			readPrompt(firstRd)
+			second, secondRd := dial()
+			defer second.Close()
+			line, err := secondRd.ReadString('\n')
+			Expect(err).NotTo(HaveOccurred())
+			Expect(line).To(Equal("Too many sessions, please try again later\n"))
+		})
+	})
+})
+
+// closingListener closes the server as soon as it has accepted a connection
+type closingListener struct {
+	net.Listener
+	server *interact.Server
+}
+
+func (l closingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		l.server.Close()
+	}
+	return conn, err
+}
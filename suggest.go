@@ -0,0 +1,109 @@
+package interact
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// An OptionError is returned by checks that only allow the input to be one of
+// a set of options, such as OneOf. If the input is close to one of the options,
+// the user is asked whether they meant that option instead.
+type OptionError struct {
+	Input   string
+	Options []string
+}
+
+func (e *OptionError) Error() string {
+	return fmt.Sprintf("Please select one of: %s", strings.Join(e.Options, ", "))
+}
+
+// OneOf returns an InputCheck that only allows the input to be one of the given
+// options
+func OneOf(options ...string) InputCheck {
+	return func(input string) error {
+		for _, option := range options {
+			if input == option {
+				return nil
+			}
+		}
+		return &OptionError{Input: input, Options: options}
+	}
+}
+
+// offerSuggestion asks the user whether they meant the option that is closest
+// to their input, if err is an OptionError and such an option exists
+func (a Actor) offerSuggestion(err error) (suggestion string, accepted bool, confirmErr error) {
+	var optionErr *OptionError
+	if !errors.As(err, &optionErr) {
+		return "", false, nil
+	}
+	suggestion, ok := suggest(optionErr.Input, optionErr.Options)
+	if !ok {
+		return "", false, nil
+	}
+	accepted, confirmErr = a.withoutHelp().Confirm(fmt.Sprintf("Did you mean '%s'?", suggestion), ConfirmDefaultToYes)
+	return suggestion, accepted, confirmErr
+}
+
+// suggest returns the option that is closest to the input, if it's close enough
+// to likely be a typo and no other option is as close
+func suggest(input string, options []string) (string, bool) {
+	var (
+		best      string
+		bestDist  = -1
+		ambiguous bool
+	)
+	for _, option := range options {
+		dist := editDistance(strings.ToLower(input), strings.ToLower(option))
+		if dist > maxTypos(option) {
+			continue
+		}
+		if bestDist == -1 || dist < bestDist {
+			best, bestDist, ambiguous = option, dist, false
+		} else if dist == bestDist {
+			ambiguous = true
+		}
+	}
+	return best, bestDist != -1 && !ambiguous
+}
+
+// maxTypos is the largest edit distance from the option at which input is
+// still considered a typo of it
+func maxTypos(option string) int {
+	if n := utf8.RuneCountInString(option) / 3; n > 1 {
+		return n
+	}
+	return 1
+}
+
+// editDistance returns the number of single character insertions, deletions,
+// substitutions and transpositions of adjacent characters it takes to turn a
+// into b
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	// d[i][j] is the distance between the first i runes of s and the first j
+	// runes of t
+	d := make([][]int, len(s)+1)
+	for i := range d {
+		d[i] = make([]int, len(t)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(s)][len(t)]
+}
@@ -0,0 +1,129 @@
+package interact_test
+
+import (
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Suggestions", func() {
+	var (
+		message     = "Environment"
+		environment = interact.OneOf("dev", "staging", "prod")
+	)
+
+	Describe("OneOf", func() {
+		BeforeEach(func() {
+			userInput = "qa\n"
+		})
+
+		It("should list the options", func() {
+			_, err := actor.Prompt(message, environment)
+			Expect(err).To(MatchError("Please select one of: dev, staging, prod"))
+			Expect(err).To(Equal(&interact.OptionError{
+				Input:   "qa",
+				Options: []string{"dev", "staging", "prod"},
+			}))
+		})
+
+		It("should not suggest anything for input that's not close to any option", func() {
+			actor.Prompt(message, environment)
+			Consistently(output).ShouldNot(gbytes.Say(`Did you mean`))
+		})
+	})
+
+	Describe("Prompt", func() {
+		Context("with the user accepting the suggestion", func() {
+			BeforeEach(func() {
+				userInput = "stagin\n\n"
+			})
+
+			It("should return the suggested option", func() {
+				input, err := actor.Prompt(message, environment)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(input).To(Equal("staging"))
+				Eventually(output).Should(gbytes.Say(`Environment: Did you mean 'staging'\? \[Y/n\]: `))
+			})
+		})
+
+		Context("with the user making a transposition typo", func() {
+			BeforeEach(func() {
+				userInput = "prdo\ny\n"
+			})
+
+			It("should return the suggested option", func() {
+				input, err := actor.Prompt(message, environment)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(input).To(Equal("prod"))
+			})
+		})
+
+		Context("with the user declining the suggestion", func() {
+			BeforeEach(func() {
+				userInput = "stagin\nn\n"
+			})
+
+			It("should return the error from the check", func() {
+				_, err := actor.Prompt(message, environment)
+				Expect(err).To(MatchError("Please select one of: dev, staging, prod"))
+			})
+		})
+
+		Context("with options that are equally close", func() {
+			BeforeEach(func() {
+				userInput = "ab\n"
+			})
+
+			It("should not suggest anything", func() {
+				_, err := actor.Prompt(message, interact.OneOf("aa", "bb"))
+				Expect(err).To(HaveOccurred())
+				Consistently(output).ShouldNot(gbytes.Say(`Did you mean`))
+			})
+		})
+	})
+
+	Describe("PromptAndRetry", func() {
+		Context("with the user declining the suggestion", func() {
+			BeforeEach(func() {
+				userInput = "stagin\nn\ny\ndev\n"
+			})
+
+			It("should ask to retry", func() {
+				input, err := actor.PromptAndRetry(message, environment)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(input).To(Equal("dev"))
+				Eventually(output).Should(gbytes.Say(`Did you mean 'staging'\? \[Y/n\]: `))
+				Eventually(output).Should(gbytes.Say(`Please select one of: dev, staging, prod\nDo you want to try again\? \[y/N\]: `))
+			})
+		})
+	})
+
+	Describe("Confirm", func() {
+		Context("with the user misspelling yes and accepting the suggestion", func() {
+			BeforeEach(func() {
+				userInput = "yse\n\n"
+			})
+
+			It("should return true", func() {
+				confirmed, err := actor.Confirm("Are you sure?", interact.ConfirmNoDefault)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(confirmed).To(BeTrue())
+				Eventually(output).Should(gbytes.Say(`Are you sure\? \[y/n\]: Did you mean 'yes'\? \[Y/n\]: `))
+			})
+		})
+
+		Context("with the user misspelling no and declining the suggestion", func() {
+			BeforeEach(func() {
+				userInput = "nop\nn\ny\n"
+			})
+
+			It("should ask again", func() {
+				confirmed, err := actor.Confirm("Are you sure?", interact.ConfirmNoDefault)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(confirmed).To(BeTrue())
+				Eventually(output).Should(gbytes.Say(`Did you mean 'no'\? \[Y/n\]: Please select y/n!\nAre you sure\? \[y/n\]: `))
+			})
+		})
+	})
+})
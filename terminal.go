@@ -0,0 +1,251 @@
+package interact
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// isTerminal reports whether v is a file (such as os.Stdout) that refers to a
+// terminal
+func isTerminal(v interface{}) bool {
+	_, ok := terminalFd(v)
+	return ok
+}
+
+func terminalFd(v interface{}) (int, bool) {
+	f, ok := v.(interface {
+		Fd() uintptr
+	})
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return 0, false
+	}
+	return int(f.Fd()), true
+}
+
+// interactive reports whether both the reader and the writer of the Actor are
+// terminals, so that keys can be read one at a time and output redrawn in place
+func (a Actor) interactive() bool {
+	return isTerminal(a.in) && isTerminal(a.w)
+}
+
+// withRawMode puts the terminal the Actor reads from into raw mode for the
+// duration of f. The terminal is restored even if f panics or the program
+// receives SIGTERM or SIGHUP in the meantime. Such a signal is sent to the
+// program again once the terminal is restored, so that it still terminates
+// the program by default. Programs with their own handlers for these signals
+// (see signal.Notify) receive them twice.
+func (a Actor) withRawMode(f func() error) error {
+	fd, _ := terminalFd(a.in)
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGHUP)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case sig := <-signals:
+			// Restore the terminal and deliver the signal again, now that
+			// we're no longer listening for it
+			term.Restore(fd, state)
+			signal.Stop(signals)
+			if p, err := os.FindProcess(os.Getpid()); err == nil {
+				p.Signal(sig)
+			}
+		case <-done:
+		}
+	}()
+	defer func() {
+		signal.Stop(signals)
+		close(done)
+		term.Restore(fd, state)
+	}()
+	return f()
+}
+
+// key is a key pressed by the user in raw mode. Printable characters are
+// represented by their rune value, other keys by the negative constants below.
+type key rune
+
+const (
+	keyUnknown key = -iota - 1
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyEnter
+	keyBackspace
+	keyTab
+	keyEscape
+	keyInterrupt
+	keyEndOfInput
+)
+
+// readKey reads a single key press from a terminal in raw mode
+func readKey(rd *bufio.Reader) (key, error) {
+	r, _, err := rd.ReadRune()
+	if err == io.EOF {
+		return keyUnknown, ErrEndOfInput
+	} else if err != nil {
+		return keyUnknown, err
+	}
+	switch r {
+	case '\r', '\n':
+		return keyEnter, nil
+	case 0x7f, '\b':
+		return keyBackspace, nil
+	case '\t':
+		return keyTab, nil
+	case 0x03:
+		return keyInterrupt, nil
+	case 0x04:
+		return keyEndOfInput, nil
+	case 0x1b:
+		return readEscapeSequence(rd)
+	}
+	if !unicode.IsPrint(r) {
+		return keyUnknown, nil
+	}
+	return key(r), nil
+}
+
+// readEscapeSequence reads the rest of an escape sequence, such as the one sent
+// for an arrow key. A lone escape is reported as keyEscape.
+func readEscapeSequence(rd *bufio.Reader) (key, error) {
+	if rd.Buffered() == 0 {
+		return keyEscape, nil
+	}
+	b, err := rd.ReadByte()
+	if err != nil {
+		return keyUnknown, err
+	} else if b != '[' && b != 'O' {
+		return keyUnknown, nil
+	}
+	// Skip any parameters, e.g. the modifiers in "\x1b[1;5A"
+	for {
+		b, err = rd.ReadByte()
+		if err != nil {
+			return keyUnknown, err
+		} else if b < '0' || b > '?' {
+			break
+		}
+	}
+	switch b {
+	case 'A':
+		return keyUp, nil
+	case 'B':
+		return keyDown, nil
+	case 'C':
+		return keyRight, nil
+	case 'D':
+		return keyLeft, nil
+	}
+	return keyUnknown, nil
+}
+
+// screen redraws a block of lines in place on a terminal in raw mode. The
+// cursor is kept at the end of the first line of the block.
+type screen struct {
+	w io.Writer
+}
+
+func (s screen) draw(lines ...string) {
+	fmt.Fprint(s.w, "\r\033[J", strings.Join(lines, "\r\n"))
+	if len(lines) > 1 {
+		fmt.Fprintf(s.w, "\033[%dA", len(lines)-1)
+	}
+	fmt.Fprint(s.w, "\r")
+	if width := utf8.RuneCountInString(lines[0]); width > 0 {
+		fmt.Fprintf(s.w, "\033[%dC", width)
+	}
+}
+
+// finish replaces the block with the given line and moves the cursor to the
+// start of the next line
+func (s screen) finish(line string) {
+	fmt.Fprint(s.w, "\r\033[J", line, "\r\n")
+}
+
+// readLineKeys reads a line from a terminal in raw mode, echoing it after the
+// prompt. When the user presses tab, complete is called with the line typed so
+// far and returns the candidates for completing it. A single candidate (or the
+// common prefix of all of them) replaces the line, otherwise the candidates
+// are listed below it.
+func (a Actor) readLineKeys(prompt string, complete func(string) []string) (string, error) {
+	var (
+		line       []rune
+		candidates []string
+		s          = screen{a.w}
+	)
+	err := a.withRawMode(func() error {
+		for {
+			s.draw(append([]string{prompt + string(line)}, candidates...)...)
+			k, err := readKey(a.rd)
+			if err != nil {
+				return err
+			}
+			candidates = nil
+			switch k {
+			case keyEnter:
+				s.finish(prompt + string(line))
+				return nil
+			case keyBackspace:
+				if len(line) > 0 {
+					line = line[:len(line)-1]
+				}
+			case keyTab:
+				if complete == nil {
+					continue
+				}
+				matches := complete(string(line))
+				if prefix := commonPrefix(matches); len(prefix) > len(string(line)) {
+					line = []rune(prefix)
+				} else if len(matches) > 1 {
+					candidates = matches
+					if len(candidates) > maxListedOptions {
+						hidden := len(candidates) - maxListedOptions
+						candidates = append(candidates[:maxListedOptions:maxListedOptions], fmt.Sprintf("(%d more)", hidden))
+					}
+				}
+			case keyInterrupt:
+				s.finish(prompt + string(line))
+				return ErrInterrupted
+			case keyEndOfInput:
+				if len(line) == 0 {
+					s.finish(prompt)
+					return ErrEndOfInput
+				}
+			default:
+				if k >= 0 && a.fits(string(line), rune(k)) {
+					line = append(line, rune(k))
+				}
+			}
+		}
+	})
+	return string(line), err
+}
+
+// commonPrefix returns the longest prefix shared by all of the strings
+func commonPrefix(strs []string) string {
+	if len(strs) == 0 {
+		return ""
+	}
+	prefix := strs[0]
+	for _, s := range strs[1:] {
+		for !strings.HasPrefix(s, prefix) {
+			_, size := utf8.DecodeLastRuneInString(prefix)
+			prefix = prefix[:len(prefix)-size]
+		}
+	}
+	return prefix
+}
@@ -0,0 +1,244 @@
+package interact
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	errTimeNotUnderstood = errors.New(`Could not understand the time, try for example "2006-01-02 15:04", "tomorrow 09:00", "next monday" or "+2h"!`)
+	errTimeTooFar        = errors.New("The time is too far from now!")
+	// maxRelativeDays is the largest number of days a relative time can be
+	// away from now, the same as for the hours, minutes and seconds
+	maxRelativeDays = int(math.MaxInt64 / int64(24*time.Hour))
+	// timeDisplayLayout is used to display interpreted times back to the user
+	timeDisplayLayout = "Mon 2006-01-02 15:04:05 MST (-0700)"
+	// timeLayouts are the absolute formats PromptTime understands
+	timeLayouts = []string{
+		time.RFC3339,
+		"2006-01-02T15:04",
+		"2006-01-02 15:04:05",
+		"2006-01-02 15:04",
+		"2006-01-02",
+		"Jan 2 2006 15:04",
+		"Jan 2 2006",
+		"Jan 2, 2006 15:04",
+		"Jan 2, 2006",
+		"2 Jan 2006 15:04",
+		"2 Jan 2006",
+		"January 2 2006 15:04",
+		"January 2 2006",
+		"January 2, 2006 15:04",
+		"January 2, 2006",
+		"2 January 2006 15:04",
+		"2 January 2006",
+	}
+	// clockLayouts are the formats of a time of day PromptTime understands
+	clockLayouts = []string{"15:04", "15:04:05", "3pm", "3:04pm", "3 pm", "3:04 pm"}
+	// relativePart matches a single amount of a unit in a relative time, e.g.
+	// "2h" or "3 days"
+	relativePart = regexp.MustCompile(`^(\d+)\s*([a-z]+)\s*`)
+	// relativeUnits maps the units of relative times to days or durations
+	relativeUnits = map[string]struct {
+		days     int
+		duration time.Duration
+	}{
+		"s": {0, time.Second}, "sec": {0, time.Second}, "secs": {0, time.Second},
+		"second": {0, time.Second}, "seconds": {0, time.Second},
+		"m": {0, time.Minute}, "min": {0, time.Minute}, "mins": {0, time.Minute},
+		"minute": {0, time.Minute}, "minutes": {0, time.Minute},
+		"h": {0, time.Hour}, "hr": {0, time.Hour}, "hrs": {0, time.Hour},
+		"hour": {0, time.Hour}, "hours": {0, time.Hour},
+		"d": {1, 0}, "day": {1, 0}, "days": {1, 0},
+		"w": {7, 0}, "week": {7, 0}, "weeks": {7, 0},
+	}
+	weekdays = map[string]time.Weekday{
+		"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday,
+		"wednesday": time.Wednesday, "thursday": time.Thursday,
+		"friday": time.Friday, "saturday": time.Saturday,
+	}
+)
+
+// TimeOptions configure how PromptTime interprets and bounds the user's input.
+// The zero value interprets times in the local time zone without any bounds.
+type TimeOptions struct {
+	// Location is the time zone used for times given without an offset and
+	// for displaying the interpreted time. Defaults to time.Local.
+	Location *time.Location
+	// Min and Max are the earliest and the latest accepted times. A zero time
+	// means no bound.
+	Min, Max time.Time
+	// Now returns the time relative expressions are based on. Defaults to
+	// time.Now.
+	Now func() time.Time
+}
+
+// PromptTime asks the user for a point in time. Besides RFC 3339 and other
+// common date formats, it accepts relative expressions such as "now",
+// "tomorrow 09:00", "next monday", "+2h" and "in 3 days". A weekday with or
+// without "next" means its first occurrence after today. The interpreted time
+// is displayed back to the user and only returned once they confirm it. If the
+// input can't be understood or is out of bounds, the user is asked whether
+// they want to try again, just like with PromptAndRetry.
+func (a Actor) PromptTime(message string, opts TimeOptions) (time.Time, error) {
+	a, unlock := a.lock()
+	defer unlock()
+	loc := opts.Location
+	if loc == nil {
+		loc = time.Local
+	}
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+	for {
+		var t time.Time
+		_, err := a.PromptAndRetry(message, func(input string) error {
+			parsed, err := parseTime(input, now().In(loc), loc)
+			if err != nil {
+				return err
+			} else if !opts.Min.IsZero() && parsed.Before(opts.Min) {
+				return fmt.Errorf("The time can not be before %s!", opts.Min.In(loc).Format(timeDisplayLayout))
+			} else if !opts.Max.IsZero() && parsed.After(opts.Max) {
+				return fmt.Errorf("The time can not be after %s!", opts.Max.In(loc).Format(timeDisplayLayout))
+			}
+			t = parsed
+			return nil
+		})
+		if err != nil {
+			return time.Time{}, err
+		}
+		question := fmt.Sprintf("Interpreted as %s. Is that correct?", t.Format(timeDisplayLayout))
+		confirmed, err := a.withoutHelp().Confirm(question, ConfirmDefaultToYes)
+		if err != nil {
+			return time.Time{}, err
+		} else if confirmed {
+			return t, nil
+		}
+	}
+}
+
+// parseTime interprets the input as an absolute time or as a time relative to
+// now
+func parseTime(input string, now time.Time, loc *time.Location) (time.Time, error) {
+	input = strings.Join(strings.Fields(input), " ")
+	lower := strings.ToLower(input)
+	if lower == "now" {
+		return now, nil
+	}
+	if t, ok, err := parseRelative(lower, now); err != nil {
+		return time.Time{}, err
+	} else if ok {
+		return t, nil
+	}
+	if t, ok := parseDay(lower, now, loc); ok {
+		return t, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, input, loc); err == nil {
+			return t, nil
+		}
+	}
+	if t, ok := atClock(now, lower, loc); ok {
+		return t, nil
+	}
+	return time.Time{}, errTimeNotUnderstood
+}
+
+// parseRelative interprets expressions like "+2h", "-1d12h", "in 3 days" and
+// "2 hours ago". An error is returned if the amounts are too large.
+func parseRelative(s string, now time.Time) (time.Time, bool, error) {
+	sign := 1
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasPrefix(s, "-"):
+		s, sign = s[1:], -1
+	case strings.HasPrefix(s, "in "):
+		s = s[len("in "):]
+	case strings.HasSuffix(s, " ago"):
+		s, sign = s[:len(s)-len(" ago")], -1
+	default:
+		return time.Time{}, false, nil
+	}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, false, nil
+	}
+	var (
+		days     int
+		duration time.Duration
+	)
+	for s != "" {
+		m := relativePart.FindStringSubmatch(s)
+		if m == nil {
+			return time.Time{}, false, nil
+		}
+		unit, ok := relativeUnits[m[2]]
+		if !ok {
+			return time.Time{}, false, nil
+		}
+		n, err := strconv.Atoi(m[1])
+		if err != nil ||
+			unit.days > 0 && n > (maxRelativeDays-days)/unit.days ||
+			unit.duration > 0 && int64(n) > int64((math.MaxInt64-duration)/unit.duration) {
+			return time.Time{}, false, errTimeTooFar
+		}
+		days += n * unit.days
+		duration += time.Duration(n) * unit.duration
+		s = strings.TrimPrefix(s[len(m[0]):], "and ")
+	}
+	return now.AddDate(0, 0, sign*days).Add(time.Duration(sign) * duration), true, nil
+}
+
+// parseDay interprets expressions like "tomorrow", "next monday" or "friday
+// 15:00". "next" is optional, so "next monday" and "monday" are the same day.
+// Without a time of day, the start of the day is used.
+func parseDay(s string, now time.Time, loc *time.Location) (time.Time, bool) {
+	fields := strings.SplitN(s, " ", 2)
+	if fields[0] == "next" && len(fields) == 2 {
+		fields = strings.SplitN(fields[1], " ", 2)
+		if _, ok := weekdays[fields[0]]; !ok {
+			return time.Time{}, false
+		}
+	}
+	var day time.Time
+	if weekday, ok := weekdays[fields[0]]; ok {
+		days := (int(weekday)-int(now.Weekday())+6)%7 + 1
+		day = now.AddDate(0, 0, days)
+	} else {
+		switch fields[0] {
+		case "today":
+			day = now
+		case "tomorrow":
+			day = now.AddDate(0, 0, 1)
+		case "yesterday":
+			day = now.AddDate(0, 0, -1)
+		default:
+			return time.Time{}, false
+		}
+	}
+	if len(fields) == 1 {
+		y, m, d := day.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, loc), true
+	}
+	return atClock(day, fields[1], loc)
+}
+
+// atClock returns the given time of day on the day of t
+func atClock(t time.Time, clock string, loc *time.Location) (time.Time, bool) {
+	for _, layout := range clockLayouts {
+		c, err := time.Parse(layout, clock)
+		if err != nil {
+			continue
+		}
+		y, m, d := t.Date()
+		return time.Date(y, m, d, c.Hour(), c.Minute(), c.Second(), 0, loc), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,138 @@
+package interact_test
+
+import (
+	"time"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("PromptTime", func() {
+	var (
+		message = "When should the maintenance start?"
+		// a Monday
+		now  = time.Date(2026, 10, 19, 14, 30, 0, 0, time.UTC)
+		opts interact.TimeOptions
+	)
+
+	BeforeEach(func() {
+		opts = interact.TimeOptions{
+			Location: time.UTC,
+			Now: func() time.Time {
+				return now
+			},
+		}
+	})
+
+	DescribeTable("interpreting the input",
+		func(input string, expected time.Time) {
+			actor = interact.NewActor(gbytes.BufferWithBytes([]byte(input+"\n\n")), output)
+			t, err := actor.PromptTime(message, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t).To(BeTemporally("==", expected))
+		},
+		Entry("now", "now", now),
+		Entry("RFC 3339", "2026-11-01T09:00:00+02:00", time.Date(2026, 11, 1, 7, 0, 0, 0, time.UTC)),
+		Entry("a date and a time", "2026-11-01 09:00", time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)),
+		Entry("a date", "2026-11-01", time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("a date with a month name", "Nov 1 2026 17:45", time.Date(2026, 11, 1, 17, 45, 0, 0, time.UTC)),
+		Entry("a time of day", "18:15", time.Date(2026, 10, 19, 18, 15, 0, 0, time.UTC)),
+		Entry("tomorrow", "tomorrow", time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)),
+		Entry("tomorrow with a time", "tomorrow 09:00", time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)),
+		Entry("yesterday with a 12-hour time", "Yesterday 9pm", time.Date(2026, 10, 18, 21, 0, 0, 0, time.UTC)),
+		Entry("a weekday", "wednesday", time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC)),
+		Entry("next weekday", "next monday 10:00", time.Date(2026, 10, 26, 10, 0, 0, 0, time.UTC)),
+		Entry("the weekday of today", "monday 10:00", time.Date(2026, 10, 26, 10, 0, 0, 0, time.UTC)),
+		Entry("next weekday later this week", "next friday", time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)),
+		Entry("a positive offset", "+2h", now.Add(2*time.Hour)),
+		Entry("a combined offset", "+1d2h30m", now.AddDate(0, 0, 1).Add(2*time.Hour+30*time.Minute)),
+		Entry("a negative offset", "-1w", now.AddDate(0, 0, -7)),
+		Entry("in words", "in 3 days and 4 hours", now.AddDate(0, 0, 3).Add(4*time.Hour)),
+		Entry("in the past in words", "15 minutes ago", now.Add(-15*time.Minute)),
+	)
+
+	Context("with the user confirming the interpreted time", func() {
+		BeforeEach(func() {
+			userInput = "tomorrow 09:00\n\n"
+		})
+
+		It("should display the interpreted time", func() {
+			actor.PromptTime(message, opts)
+			Eventually(output).Should(gbytes.Say(`When should the maintenance start\?: `))
+			Eventually(output).Should(gbytes.Say(`Interpreted as Tue 2026-10-20 09:00:00 UTC \(\+0000\)\. Is that correct\? \[Y/n\]: `))
+		})
+	})
+
+	Context("with the user rejecting the interpreted time", func() {
+		BeforeEach(func() {
+			userInput = "monday\nn\nnow\ny\n"
+		})
+
+		It("should ask again", func() {
+			t, err := actor.PromptTime(message, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t).To(BeTemporally("==", now))
+		})
+	})
+
+	DescribeTable("rejecting offsets that are too large",
+		func(input string) {
+			actor = interact.NewActor(gbytes.BufferWithBytes([]byte(input+"\n\n")), output)
+			_, err := actor.PromptTime(message, opts)
+			Expect(err).To(Equal(interact.ErrCanceled))
+			Eventually(output).Should(gbytes.Say(`The time is too far from now!\nDo you want to try again\? \[y/N\]: `))
+		},
+		Entry("in hours", "+9999999999h"),
+		Entry("in days", "in 9999999999 days"),
+		Entry("in combined units", "+100000w and 100000w"),
+		Entry("with too many digits", "-99999999999999999999s"),
+	)
+
+	Context("with input that can't be understood", func() {
+		BeforeEach(func() {
+			userInput = "whenever\ny\n+1h\n\n"
+		})
+
+		It("should ask to retry", func() {
+			t, err := actor.PromptTime(message, opts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t).To(BeTemporally("==", now.Add(time.Hour)))
+			Eventually(output).Should(gbytes.Say(`Could not understand the time`))
+			Eventually(output).Should(gbytes.Say(`Do you want to try again\? \[y/N\]: `))
+		})
+	})
+
+	Context("with bounds", func() {
+		BeforeEach(func() {
+			opts.Min = now
+			opts.Max = now.AddDate(0, 0, 7)
+		})
+
+		Context("with a time in the past", func() {
+			BeforeEach(func() {
+				userInput = "yesterday\nn\n"
+			})
+
+			It("should reject the time", func() {
+				_, err := actor.PromptTime(message, opts)
+				Expect(err).To(HaveOccurred())
+				Eventually(output).Should(gbytes.Say(`The time can not be before Mon 2026-10-19 14:30:00 UTC \(\+0000\)!`))
+			})
+		})
+
+		Context("with a time too far in the future", func() {
+			BeforeEach(func() {
+				userInput = "+2w\nn\n"
+			})
+
+			It("should reject the time", func() {
+				_, err := actor.PromptTime(message, opts)
+				Expect(err).To(HaveOccurred())
+				Eventually(output).Should(gbytes.Say(`The time can not be after Mon 2026-10-26 14:30:00 UTC \(\+0000\)!`))
+			})
+		})
+	})
+})
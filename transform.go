@@ -0,0 +1,54 @@
+package interact
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Transform specifies the function signature for an input transformation.
+// Transformations are applied to the user's input before any checks are run.
+type Transform func(string) string
+
+// Transformations that can be used with WithTransforms
+var (
+	// TrimSpace removes all leading and trailing whitespace. This is the
+	// default transformation.
+	TrimSpace Transform = strings.TrimSpace
+	// TrimNewline only removes the line terminator ("\n" or "\r\n"). Since
+	// the Actor removes it from the input anyway, this keeps the input as
+	// typed.
+	TrimNewline Transform = func(input string) string {
+		return strings.TrimSuffix(strings.TrimSuffix(input, "\n"), "\r")
+	}
+	// Lowercase maps all letters to lower case
+	Lowercase Transform = strings.ToLower
+	// NormalizeNFC converts the input to Unicode Normalization Form C, so that
+	// for example a precomposed "é" and an "e" followed by a combining acute
+	// accent are considered equal
+	NormalizeNFC Transform = norm.NFC.String
+	// CollapseSpace replaces every run of whitespace with a single space and
+	// removes leading and trailing whitespace
+	CollapseSpace Transform = func(input string) string {
+		return strings.Join(strings.Fields(input), " ")
+	}
+)
+
+// WithTransforms returns a copy of the Actor that applies the given
+// transformations, in order, to the input of its prompts instead of the
+// default TrimSpace. Calling it without any transformations disables trimming
+// altogether, except for the line terminator, which is always removed. The
+// answers with a special meaning, like an empty line for the default option or
+// the help token, are recognized before the transformations are applied and
+// regardless of the whitespace around them.
+func (a Actor) WithTransforms(transforms ...Transform) Actor {
+	a.transforms = append([]Transform{}, transforms...)
+	return a
+}
+
+func (a Actor) transform(input string) string {
+	for _, transform := range a.transforms {
+		input = transform(input)
+	}
+	return input
+}
@@ -0,0 +1,128 @@
+package interact_test
+
+import (
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Transform", func() {
+	var message = "Please answer"
+
+	Context("with input surrounded by whitespace", func() {
+		BeforeEach(func() {
+			userInput = "  Hello\tWorld  \r\n"
+		})
+
+		It("should trim the input by default", func() {
+			input, err := actor.Prompt(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("Hello\tWorld"))
+		})
+
+		It("should only remove the line terminator without any transforms", func() {
+			input, err := actor.WithTransforms().Prompt(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("  Hello\tWorld  "))
+		})
+
+		It("should only trim the line terminator with TrimNewline", func() {
+			input, err := actor.WithTransforms(interact.TrimNewline).Prompt(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("  Hello\tWorld  "))
+		})
+
+		It("should collapse whitespace with CollapseSpace", func() {
+			input, err := actor.WithTransforms(interact.CollapseSpace).Prompt(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("Hello World"))
+		})
+
+		It("should apply the transforms in order", func() {
+			input, err := actor.WithTransforms(interact.TrimSpace, interact.Lowercase).Prompt(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("hello\tworld"))
+		})
+
+		It("should run the checks on the transformed input", func() {
+			_, err := actor.WithTransforms(interact.TrimNewline).Prompt(message, func(input string) error {
+				Expect(input).To(Equal("  Hello\tWorld  "))
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not affect the original actor", func() {
+			actor.WithTransforms()
+			input, err := actor.Prompt(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("Hello\tWorld"))
+		})
+	})
+
+	Context("with decomposed unicode input", func() {
+		BeforeEach(func() {
+			userInput = "cafe\u0301\n"
+		})
+
+		It("should compose the characters with NormalizeNFC", func() {
+			input, err := actor.WithTransforms(interact.TrimSpace, interact.NormalizeNFC).Prompt(message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(Equal("caf\u00e9"))
+		})
+	})
+
+	Context("with special answers and no trimming", func() {
+		var untrimmed interact.Actor
+
+		JustBeforeEach(func() {
+			untrimmed = actor.WithTransforms()
+		})
+
+		Context("with an empty line", func() {
+			BeforeEach(func() {
+				userInput = "\n"
+			})
+
+			It("should use the default option", func() {
+				Expect(untrimmed.PromptOptional(message, "def")).To(Equal("def"))
+			})
+
+			It("should use the default option with other transforms", func() {
+				Expect(actor.WithTransforms(interact.Lowercase).PromptOptional(message, "def")).To(Equal("def"))
+			})
+		})
+
+		Context("with the clear token", func() {
+			BeforeEach(func() {
+				userInput = "-\r\n"
+			})
+
+			It("should clear the value", func() {
+				Expect(untrimmed.WithClearToken("-").PromptOptional(message, "def")).To(Equal(""))
+			})
+		})
+
+		Context("with the help token", func() {
+			BeforeEach(func() {
+				userInput = "?\nAnswer\n"
+			})
+
+			It("should show the help", func() {
+				Expect(untrimmed.WithHelp("Some help").Prompt(message)).To(Equal("Answer"))
+				Eventually(output).Should(gbytes.Say(`Some help\n`))
+			})
+		})
+
+		Context("with a list", func() {
+			BeforeEach(func() {
+				userInput = " a\nb\n-\n\n"
+			})
+
+			It("should remove items and finish on an empty line", func() {
+				Expect(untrimmed.PromptList(message)).To(Equal([]string{" a"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,17 @@
+package interact
+
+import "errors"
+
+// A Warning can be returned by an InputCheck to flag input that is allowed, but
+// unusual. Unlike with other errors, the user is shown the warning and then
+// asked whether they want to continue with the input anyway.
+type Warning string
+
+func (w Warning) Error() string {
+	return string(w)
+}
+
+func isWarning(err error) bool {
+	var w Warning
+	return errors.As(err, &w)
+}
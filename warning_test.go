@@ -0,0 +1,103 @@
+package interact_test
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Warning", func() {
+	var (
+		message    = "How many replicas?"
+		checkCount = func(input string) error {
+			n, err := strconv.Atoi(input)
+			if err != nil {
+				return errors.New("Please enter a number!")
+			} else if n > 10 {
+				return interact.Warning("More than 10 replicas is unusual.")
+			}
+			return nil
+		}
+	)
+
+	Describe("Prompt", func() {
+		Context("with the user continuing anyway", func() {
+			BeforeEach(func() {
+				userInput = "12\ny\n"
+			})
+
+			It("should return the input", func() {
+				input, err := actor.Prompt(message, checkCount)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(input).To(Equal("12"))
+			})
+
+			It("should show the warning and ask to continue", func() {
+				actor.Prompt(message, checkCount)
+				Eventually(output).Should(gbytes.Say(`How many replicas\?: `))
+				Eventually(output).Should(gbytes.Say(`More than 10 replicas is unusual\.\n`))
+				Eventually(output).Should(gbytes.Say(`Do you want to continue anyway\? \[y/N\]: `))
+			})
+		})
+
+		Context("with the user not continuing", func() {
+			BeforeEach(func() {
+				userInput = "12\n\n"
+			})
+
+			It("should return the warning", func() {
+				_, err := actor.Prompt(message, checkCount)
+				Expect(err).To(Equal(interact.Warning("More than 10 replicas is unusual.")))
+			})
+		})
+
+		Context("with a failing check after the warning", func() {
+			BeforeEach(func() {
+				userInput = "12\n"
+			})
+
+			It("should return the error without asking to continue", func() {
+				checkErr := errors.New("Check failed!")
+				_, err := actor.Prompt(message, checkCount, func(string) error {
+					return checkErr
+				})
+				Expect(err).To(Equal(checkErr))
+				Consistently(output).ShouldNot(gbytes.Say(`continue anyway`))
+			})
+		})
+	})
+
+	Describe("PromptAndRetry", func() {
+		Context("with the user not continuing and then entering a usual value", func() {
+			BeforeEach(func() {
+				userInput = "12\nn\n3\n"
+			})
+
+			It("should ask again without asking to retry", func() {
+				input, err := actor.PromptAndRetry(message, checkCount)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(input).To(Equal("3"))
+				Eventually(output).Should(gbytes.Say(`Do you want to continue anyway\? \[y/N\]: `))
+				Eventually(output).Should(gbytes.Say(`How many replicas\?: `))
+				Consistently(output).ShouldNot(gbytes.Say(`try again`))
+			})
+		})
+
+		Context("with an error", func() {
+			BeforeEach(func() {
+				userInput = "many\ny\n3\n"
+			})
+
+			It("should ask to retry", func() {
+				input, err := actor.PromptAndRetry(message, checkCount)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(input).To(Equal("3"))
+				Eventually(output).Should(gbytes.Say(`Please enter a number!\nDo you want to try again\? \[y/N\]: `))
+			})
+		})
+	})
+})
@@ -0,0 +1,244 @@
+package interact
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	errNotLocalhost = errors.New("The web UI can only listen on localhost")
+	webUITemplate   = template.Must(template.New("webui").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{with .Question}}{{.Message}}{{else}}Waiting{{end}}</title>
+{{if not .Question}}<meta http-equiv="refresh" content="1; url={{.URL}}">{{end}}
+<style>
+body { font-family: sans-serif; max-width: 40em; margin: 2em auto; }
+pre { background: #eee; padding: 1em; white-space: pre-wrap; }
+.error { color: #b00; }
+.help { color: #555; white-space: pre-wrap; }
+</style>
+</head>
+<body>
+{{if .Log}}<pre>{{.Log}}</pre>{{end}}
+{{with .Question}}
+<form method="post" action="{{$.URL}}">
+<input type="hidden" name="token" value="{{$.Token}}">
+<input type="hidden" name="id" value="{{.Seq}}">
+<p><label for="answer">{{.Message}}</label></p>
+{{if .Help}}<p class="help">{{.Help}}</p>{{end}}
+{{if .Error}}<p class="error">{{.Error}}</p>{{end}}
+{{if eq .Kind "input"}}<input id="answer" name="answer" placeholder="{{.Default}}{{if and .Default .ClearToken}} ({{.ClearToken}} to clear){{end}}" autofocus>
+{{else if eq .Kind "secret"}}<input id="answer" type="password" name="answer" autofocus>
+{{else if eq .Kind "confirm"}}<button name="answer" value="y"{{if eq .Default "y"}} autofocus{{end}}>Yes</button>
+<button name="answer" value="n"{{if eq .Default "n"}} autofocus{{end}}>No</button>
+{{else if eq .Kind "select"}}<select id="answer" name="answer" autofocus>
+{{range $i, $option := .Options}}<option value="{{$i}}">{{$option}}</option>
+{{end}}</select>
+{{else if eq .Kind "choice"}}{{range $i, $key := .Options}}<button name="answer" value="{{$key}}">{{$key}} - {{index $.Question.Descriptions $i}}</button>
+{{end}}{{else if eq .Kind "multiselect"}}{{range $i, $option := .Options}}<label><input type="checkbox" name="answer" value="{{$i}}"> {{$option}}</label><br>
+{{end}}{{end}}
+{{if and (ne .Kind "confirm") (ne .Kind "choice")}}<button type="submit">OK</button>{{end}}
+<button name="cancel" value="1">Cancel</button>
+</form>
+{{else}}<p>Waiting for the next question&hellip;</p>{{end}}
+</body>
+</html>
+`))
+)
+
+// A WebUI serves the prompts of an Actor as HTML forms on a web server on
+// localhost, for users who'd rather answer them in a browser than in a
+// terminal. Prompt and PromptSecret are rendered as text fields, Confirm and
+// Choose as buttons, and Select, MultiSelect and FuzzySelect as lists of
+// options. If a check fails, the question is asked again with the error shown
+// next to it. Anything else the Actor writes is shown above the question.
+//
+// The URL of the web UI contains a random token that every request must
+// include, so that other users and web sites can't answer the prompts.
+type WebUI struct {
+	listener  net.Listener
+	server    *http.Server
+	token     string
+	actor     Actor
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu      sync.Mutex
+	log     bytes.Buffer
+	lastSeq int
+	pending *webQuestion
+}
+
+type webQuestion struct {
+	question
+	Seq    int
+	answer chan webAnswer
+}
+
+type webAnswer struct {
+	value string
+	err   error
+}
+
+// NewWebUI starts serving the web UI on the address, which must be on
+// localhost, e.g. "localhost:8080" or "127.0.0.1:0" to pick any free port
+func NewWebUI(address string) (*WebUI, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	} else if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, errNotLocalhost
+	}
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	ui := &WebUI{
+		listener: l,
+		token:    hex.EncodeToString(token),
+		done:     make(chan struct{}),
+	}
+	ui.server = &http.Server{Handler: ui}
+	ui.actor = NewActor(strings.NewReader(""), webUILog{ui})
+	ui.actor.ui = ui
+	go ui.server.Serve(l)
+	return ui, nil
+}
+
+// URL returns the address the user should open in their browser
+func (ui *WebUI) URL() string {
+	return fmt.Sprintf("http://%s/?token=%s", ui.listener.Addr(), ui.token)
+}
+
+// Actor returns the Actor whose prompts are served by the web UI
+func (ui *WebUI) Actor() Actor {
+	return ui.actor
+}
+
+// Close stops the web server. Any prompts waiting for an answer return
+// ErrEndOfInput.
+func (ui *WebUI) Close() error {
+	ui.closeOnce.Do(func() {
+		close(ui.done)
+	})
+	return ui.server.Close()
+}
+
+func (ui *WebUI) ask(q question) (string, error) {
+	ui.mu.Lock()
+	ui.lastSeq++
+	wq := &webQuestion{question: q, Seq: ui.lastSeq, answer: make(chan webAnswer, 1)}
+	ui.pending = wq
+	ui.mu.Unlock()
+	select {
+	case answer := <-wq.answer:
+		return answer.value, answer.err
+	case <-ui.done:
+		return "", ErrEndOfInput
+	}
+}
+
+func (ui *WebUI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	} else if subtle.ConstantTimeCompare([]byte(r.FormValue("token")), []byte(ui.token)) != 1 {
+		http.Error(w, "Invalid token", http.StatusForbidden)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		ui.render(w)
+	case http.MethodPost:
+		ui.answer(r)
+		http.Redirect(w, r, ui.path(), http.StatusSeeOther)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (ui *WebUI) path() string {
+	return "/?token=" + url.QueryEscape(ui.token)
+}
+
+func (ui *WebUI) render(w http.ResponseWriter) {
+	ui.mu.Lock()
+	data := struct {
+		URL, Token, Log string
+		Question        *webQuestion
+	}{ui.path(), ui.token, ui.log.String(), ui.pending}
+	ui.mu.Unlock()
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	webUITemplate.Execute(w, data)
+}
+
+// answer passes the submitted form to the pending question. Forms of questions
+// that have already been answered are ignored.
+func (ui *WebUI) answer(r *http.Request) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+	q := ui.pending
+	if q == nil || r.PostFormValue("id") != strconv.Itoa(q.Seq) {
+		return
+	}
+	ui.pending = nil
+	if r.PostFormValue("cancel") != "" {
+		fmt.Fprintf(&ui.log, "%s: (canceled)\n", q.Message)
+		q.answer <- webAnswer{err: ErrCanceled}
+		return
+	}
+	value := strings.Join(r.PostForm["answer"], ",")
+	fmt.Fprintf(&ui.log, "%s: %s\n", q.Message, q.display(value))
+	q.answer <- webAnswer{value: value}
+}
+
+// display returns the answer as it's shown in the log of the web UI
+func (q question) display(answer string) string {
+	switch q.Kind {
+	case kindSecret:
+		if answer != "" {
+			return secretMask
+		}
+	case kindInput, kindConfirm:
+		if answer == "" {
+			return q.Default
+		}
+	case kindSelect, kindMultiSelect:
+		var names []string
+		for _, field := range strings.Split(answer, ",") {
+			if i, err := strconv.Atoi(field); err == nil && i >= 0 && i < len(q.Options) {
+				names = append(names, q.Options[i])
+			}
+		}
+		return strings.Join(names, ", ")
+	}
+	return answer
+}
+
+// webUILog collects the output of the Actor of a WebUI
+type webUILog struct {
+	ui *WebUI
+}
+
+func (l webUILog) Write(p []byte) (int, error) {
+	l.ui.mu.Lock()
+	defer l.ui.mu.Unlock()
+	return l.ui.log.Write(p)
+}
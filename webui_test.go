@@ -0,0 +1,186 @@
+package interact_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/deiwin/interact"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebUI", func() {
+	var (
+		ui      *interact.WebUI
+		results chan interface{}
+		errs    chan error
+		idRe    = regexp.MustCompile(`name="id" value="(\d+)"`)
+	)
+
+	BeforeEach(func() {
+		var err error
+		ui, err = interact.NewWebUI("127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		results = make(chan interface{}, 1)
+		errs = make(chan error, 1)
+	})
+
+	AfterEach(func() {
+		ui.Close()
+	})
+
+	run := func(f func(a interact.Actor) (interface{}, error)) {
+		go func() {
+			result, err := f(ui.Actor())
+			if err != nil {
+				errs <- err
+				return
+			}
+			results <- result
+		}()
+	}
+
+	page := func() string {
+		resp, err := http.Get(ui.URL())
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(body)
+	}
+
+	// submit waits for a question whose form matches the pattern and submits
+	// the form with the values
+	submit := func(pattern string, values url.Values) {
+		var body string
+		Eventually(func() string {
+			body = page()
+			return body
+		}).Should(MatchRegexp(pattern))
+		values.Set("id", idRe.FindStringSubmatch(body)[1])
+		resp, err := http.PostForm(ui.URL(), values)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	}
+
+	It("should only listen on localhost", func() {
+		_, err := interact.NewWebUI("0.0.0.0:0")
+		Expect(err).To(HaveOccurred())
+		_, err = interact.NewWebUI("example.com:80")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject requests without the token", func() {
+		u := strings.Split(ui.URL(), "?")[0]
+		resp, err := http.Get(u)
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+		resp, err = http.PostForm(u, url.Values{"answer": {"y"}})
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+	})
+
+	It("should wait for a question", func() {
+		Expect(page()).To(ContainSubstring("Waiting for the next question"))
+	})
+
+	It("should render Prompt as a text field", func() {
+		run(func(a interact.Actor) (interface{}, error) {
+			return a.PromptOptional("Name", "<none>")
+		})
+		submit(`<input id="answer" name="answer" placeholder="&lt;none&gt;"`, url.Values{"answer": {" Alice "}})
+		Eventually(results).Should(Receive(Equal("Alice")))
+		Expect(page()).To(ContainSubstring("Name:  Alice"))
+	})
+
+	It("should use the default for an empty answer", func() {
+		run(func(a interact.Actor) (interface{}, error) {
+			return a.PromptOptional("Name", "Bob")
+		})
+		submit(`placeholder="Bob"`, url.Values{"answer": {""}})
+		Eventually(results).Should(Receive(Equal("Bob")))
+	})
+
+	It("should render PromptSecret as a password field and hide the answer", func() {
+		run(func(a interact.Actor) (interface{}, error) {
+			return a.PromptSecret("Password")
+		})
+		submit(`type="password"`, url.Values{"answer": {"hunter2"}})
+		Eventually(results).Should(Receive(Equal("hunter2")))
+		body := page()
+		Expect(body).To(ContainSubstring("Password: ****\n"))
+		Expect(body).NotTo(ContainSubstring("*****"))
+		Expect(body).NotTo(ContainSubstring("hunter2"))
+	})
+
+	It("should show failed checks inline and ask again", func() {
+		run(func(a interact.Actor) (interface{}, error) {
+			return a.PromptAndRetry("Port", func(input string) error {
+				if input != "80" {
+					return errors.New("Please enter 80!")
+				}
+				return nil
+			})
+		})
+		submit(`name="answer"`, url.Values{"answer": {"8080"}})
+		submit(`class="error">Please enter 80!`, url.Values{"answer": {"80"}})
+		Eventually(results).Should(Receive(Equal("80")))
+	})
+
+	It("should render Confirm as buttons", func() {
+		run(func(a interact.Actor) (interface{}, error) {
+			return a.Confirm("Continue?", interact.ConfirmDefaultToNo)
+		})
+		submit(`<button name="answer" value="n" autofocus>No</button>`, url.Values{"answer": {"y"}})
+		Eventually(results).Should(Receive(Equal(true)))
+	})
+
+	It("should render Choose as buttons", func() {
+		run(func(a interact.Actor) (interface{}, error) {
+			return a.Choose("Apply?", []interact.Choice{{Key: "a", Description: "accept"}, {Key: "s", Description: "skip"}})
+		})
+		submit(`<button name="answer" value="s">s - skip</button>`, url.Values{"answer": {"s"}})
+		Eventually(results).Should(Receive(Equal("s")))
+	})
+
+	It("should render Select as a list of options", func() {
+		run(func(a interact.Actor) (interface{}, error) {
+			return a.Select("Color", []string{"red", "green", "blue"})
+		})
+		submit(`<option value="1">green</option>`, url.Values{"answer": {"1"}})
+		Eventually(results).Should(Receive(Equal(1)))
+		Expect(page()).To(ContainSubstring("Color: green"))
+	})
+
+	It("should render MultiSelect as checkboxes", func() {
+		run(func(a interact.Actor) (interface{}, error) {
+			return a.MultiSelect("Colors", []string{"red", "green", "blue"})
+		})
+		submit(`type="checkbox" name="answer" value="2"`, url.Values{"answer": {"0", "2"}})
+		Eventually(results).Should(Receive(Equal([]int{0, 2})))
+	})
+
+	It("should return ErrCanceled if the user cancels", func() {
+		run(func(a interact.Actor) (interface{}, error) {
+			return a.Prompt("Name")
+		})
+		submit(`name="cancel"`, url.Values{"cancel": {"1"}})
+		Eventually(errs).Should(Receive(Equal(interact.ErrCanceled)))
+	})
+
+	It("should return ErrEndOfInput once closed", func() {
+		run(func(a interact.Actor) (interface{}, error) {
+			return a.Prompt("Name")
+		})
+		Eventually(page).Should(ContainSubstring("Name"))
+		ui.Close()
+		Eventually(errs).Should(Receive(Equal(interact.ErrEndOfInput)))
+	})
+})